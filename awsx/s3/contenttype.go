@@ -0,0 +1,88 @@
+package s3
+
+import (
+	"path"
+	"strings"
+)
+
+// DefaultContentType 為無法辨識副檔名時使用的 Content-Type。
+const DefaultContentType = "application/octet-stream"
+
+// contentTypes 為常見副檔名對應的 MIME type（不依賴系統 mime.types，確保各環境結果一致）。
+var contentTypes = map[string]string{
+	// 圖片
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".gif":  "image/gif",
+	".webp": "image/webp",
+	".svg":  "image/svg+xml",
+	".ico":  "image/x-icon",
+	".bmp":  "image/bmp",
+	".tif":  "image/tiff",
+	".tiff": "image/tiff",
+	".avif": "image/avif",
+	".heic": "image/heic",
+
+	// 影音
+	".mp4":  "video/mp4",
+	".webm": "video/webm",
+	".mov":  "video/quicktime",
+	".m3u8": "application/vnd.apple.mpegurl",
+	".ts":   "video/mp2t",
+	".mp3":  "audio/mpeg",
+	".wav":  "audio/wav",
+	".ogg":  "audio/ogg",
+	".m4a":  "audio/mp4",
+
+	// 文件
+	".pdf":  "application/pdf",
+	".doc":  "application/msword",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xls":  "application/vnd.ms-excel",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".ppt":  "application/vnd.ms-powerpoint",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+
+	// 文字與網頁
+	".txt":  "text/plain; charset=utf-8",
+	".csv":  "text/csv; charset=utf-8",
+	".html": "text/html; charset=utf-8",
+	".htm":  "text/html; charset=utf-8",
+	".css":  "text/css; charset=utf-8",
+	".js":   "text/javascript; charset=utf-8",
+	".mjs":  "text/javascript; charset=utf-8",
+	".json": "application/json",
+	".xml":  "application/xml",
+	".md":   "text/markdown; charset=utf-8",
+	".wasm": "application/wasm",
+
+	// 字型
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".ttf":   "font/ttf",
+	".otf":   "font/otf",
+
+	// 壓縮檔
+	".zip": "application/zip",
+	".gz":  "application/gzip",
+	".tar": "application/x-tar",
+	".7z":  "application/x-7z-compressed",
+}
+
+// ContentTypeByExtension 依檔名副檔名回傳 Content-Type（不分大小寫），
+// 無法辨識時回傳 DefaultContentType（application/octet-stream）。
+//
+//	s3.ContentTypeByExtension("photo.JPG") // "image/jpeg"
+func ContentTypeByExtension(filename string) string {
+	return ContentTypeByExtensionWithFallback(filename, DefaultContentType)
+}
+
+// ContentTypeByExtensionWithFallback 同 ContentTypeByExtension，但無法辨識時回傳 fallback。
+func ContentTypeByExtensionWithFallback(filename, fallback string) string {
+	ext := strings.ToLower(path.Ext(filename))
+	if ct, ok := contentTypes[ext]; ok {
+		return ct
+	}
+	return fallback
+}
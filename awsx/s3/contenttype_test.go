@@ -0,0 +1,71 @@
+package s3
+
+import "testing"
+
+func TestContentTypeByExtension(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     string
+	}{
+		{"photo.jpg", "image/jpeg"},
+		{"photo.jpeg", "image/jpeg"},
+		{"PHOTO.JPG", "image/jpeg"},
+		{"icon.png", "image/png"},
+		{"anim.gif", "image/gif"},
+		{"image.webp", "image/webp"},
+		{"logo.svg", "image/svg+xml"},
+		{"favicon.ico", "image/x-icon"},
+		{"clip.mp4", "video/mp4"},
+		{"clip.webm", "video/webm"},
+		{"clip.mov", "video/quicktime"},
+		{"playlist.m3u8", "application/vnd.apple.mpegurl"},
+		{"song.mp3", "audio/mpeg"},
+		{"sound.wav", "audio/wav"},
+		{"doc.pdf", "application/pdf"},
+		{"report.docx", "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		{"sheet.xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+		{"notes.txt", "text/plain; charset=utf-8"},
+		{"data.csv", "text/csv; charset=utf-8"},
+		{"index.html", "text/html; charset=utf-8"},
+		{"style.css", "text/css; charset=utf-8"},
+		{"app.js", "text/javascript; charset=utf-8"},
+		{"config.json", "application/json"},
+		{"feed.xml", "application/xml"},
+		{"font.woff2", "font/woff2"},
+		{"archive.zip", "application/zip"},
+		{"backup.tar.gz", "application/gzip"},
+		{"uploads/2025/12/photo.png", "image/png"},
+		{"unknown.xyz", DefaultContentType},
+		{"no_extension", DefaultContentType},
+		{"", DefaultContentType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			if got := ContentTypeByExtension(tt.filename); got != tt.want {
+				t.Errorf("ContentTypeByExtension(%q) = %q, want %q", tt.filename, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContentTypeByExtensionWithFallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		fallback string
+		want     string
+	}{
+		{"known", "photo.jpg", "text/plain", "image/jpeg"},
+		{"unknown", "file.xyz", "text/plain", "text/plain"},
+		{"no_extension", "README", "text/plain", "text/plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContentTypeByExtensionWithFallback(tt.filename, tt.fallback); got != tt.want {
+				t.Errorf("ContentTypeByExtensionWithFallback(%q, %q) = %q, want %q", tt.filename, tt.fallback, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,13 @@
 //
 // 目前支援：
 //   - S3 路徑前綴建構
+//   - S3 key 清理
+//   - 日期分片 key
+//   - 衍生資源（縮圖等）key
+//   - S3 URI 解析與建構
+//   - CloudFront URL 建構
+//   - S3 事件通知解析（Lambda 觸發）
+//   - 依副檔名推斷 Content-Type
 //
 // # S3 路徑工具
 //
@@ -10,8 +17,95 @@
 //	prefix := s3.BuildS3Prefix("bucket/prefix", "media/images")
 //	// prefix = "bucket/prefix/media/images/"
 //
+// mediaPrefix 為空時 BuildS3Prefix 會產生 "bucket//"；BuildS3PrefixClean 略過空片段：
+//
+//	prefix := s3.BuildS3PrefixClean("bucket", "")
+//	// prefix = "bucket/"
+//
 // BuildPrefix 通用路徑前綴建構（支援多段）：
 //
 //	prefix := s3.BuildPrefix("uploads", "2025", "12")
 //	// prefix = "uploads/2025/12/"
+//
+// SplitKey 與 KeyFileName 將 key 拆回片段（捨棄空片段）：
+//
+//	parts := s3.SplitKey("/uploads//2025/photo.jpg")
+//	// parts = []string{"uploads", "2025", "photo.jpg"}
+//	name := s3.KeyFileName("uploads/2025/photo.jpg")
+//	// name = "photo.jpg"
+//
+// # S3 Key 清理
+//
+// SanitizeS3Key 移除控制字元、轉換反斜線、折疊連續斜線並移除開頭斜線：
+//
+//	key := s3.SanitizeS3Key("/uploads\\2025//photo.jpg")
+//	// key = "uploads/2025/photo.jpg"
+//
+// # 日期分片
+//
+// 以 UTC 日期（或小時）分片，避免大型 bucket 的前綴 hot-spot：
+//
+//	key := s3.DateShardedKey("uploads", "photo.jpg", t)
+//	// key = "uploads/2025/12/19/photo.jpg"
+//	key := s3.HourShardedKey("logs", "app.log", t)
+//	// key = "logs/2025/12/19/08/app.log"
+//
+// 只需要分區前綴（例如列出某天的物件）時，使用 BuildDatePrefix / BuildHourPrefix（可選擇傳入時區）：
+//
+//	prefix := s3.BuildDatePrefix("uploads", t)
+//	// prefix = "uploads/2025/12/19/"
+//	prefix := s3.BuildHourPrefix("logs", t, loc)
+//	// prefix = "logs/2025/12/19/16/"
+//
+// # 衍生資源
+//
+// 在原始檔名前插入衍生資源路徑（縮圖、轉檔等）：
+//
+//	key := s3.ThumbnailKey("images/photo.jpg", "300x300")
+//	// key = "images/thumbs/300x300/photo.jpg"
+//	key := s3.VariantKey("videos/clip.mp4", "720p")
+//	// key = "videos/720p/clip.mp4"
+//
+// CDN cache-busting：在副檔名前插入版本或內容雜湊：
+//
+//	key := s3.VersionedKey("assets/style.css", "v3")
+//	// key = "assets/style.v3.css"
+//	key := s3.HashedKey("static/app.js", "3f2a9c1b")
+//	// key = "static/app.3f2a9c1b.js"
+//
+// # S3 URI
+//
+// 解析與建構 s3://bucket/key 格式的 URI（會驗證 bucket 名稱）：
+//
+//	bucket, key, err := s3.ParseS3URI("s3://my-bucket/uploads/photo.jpg")
+//	// bucket = "my-bucket", key = "uploads/photo.jpg"
+//	uri := s3.BuildS3URI("my-bucket", "uploads/photo.jpg")
+//	// uri = "s3://my-bucket/uploads/photo.jpg"
+//
+// # CloudFront URL
+//
+// 建立透過 CloudFront 提供的 HTTPS URL（不會產生 //）：
+//
+//	url := s3.CloudFrontURL("d111111abcdef8", "images/photo.jpg")
+//	// url = "https://d111111abcdef8.cloudfront.net/images/photo.jpg"
+//	url := s3.CloudFrontDomainURL("cdn.example.com", "/images/photo.jpg")
+//	// url = "https://cdn.example.com/images/photo.jpg"
+//
+// # S3 事件
+//
+// 解析 S3 事件通知（例如 Lambda 的觸發 payload），object key 會自動 URL 解碼：
+//
+//	records, err := s3.ParseS3Event(body)
+//	for _, r := range records {
+//	    fmt.Println(r.EventName, r.Bucket, r.Key) // "images%2Fmy+photo.jpg" → "images/my photo.jpg"
+//	}
+//
+// # Content-Type
+//
+// 上傳時依副檔名設定 Content-Type（無法辨識時為 application/octet-stream）：
+//
+//	ct := s3.ContentTypeByExtension("photo.jpg")
+//	// ct = "image/jpeg"
+//	ct := s3.ContentTypeByExtensionWithFallback("file.xyz", "text/plain")
+//	// ct = "text/plain"
 package s3
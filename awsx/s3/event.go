@@ -0,0 +1,63 @@
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// S3EventRecord 為 S3 事件通知（例如觸發 Lambda）中單筆 record 的常用欄位。
+type S3EventRecord struct {
+	Bucket    string
+	Key       string // 已 URL 解碼的 object key
+	Size      int64  // 刪除事件沒有此欄位，為 0
+	ETag      string
+	EventName string // 例如 "ObjectCreated:Put"
+	EventTime time.Time
+}
+
+// s3Event 對應 S3 事件通知的 JSON 結構（僅解析需要的欄位）。
+type s3Event struct {
+	Records []struct {
+		EventName string    `json:"eventName"`
+		EventTime time.Time `json:"eventTime"`
+		S3        struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key  string `json:"key"`
+				Size int64  `json:"size"`
+				ETag string `json:"eTag"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+// ParseS3Event 解析 S3 事件通知的 JSON payload。
+// 事件中的 object key 為 URL 編碼（空白編碼為 +），會自動解碼：
+// "images%2Fmy+photo.jpg" → "images/my photo.jpg"。
+func ParseS3Event(body []byte) ([]S3EventRecord, error) {
+	var ev s3Event
+	if err := json.Unmarshal(body, &ev); err != nil {
+		return nil, fmt.Errorf("s3: parse event: %w", err)
+	}
+
+	records := make([]S3EventRecord, 0, len(ev.Records))
+	for i, r := range ev.Records {
+		key, err := url.QueryUnescape(r.S3.Object.Key)
+		if err != nil {
+			return nil, fmt.Errorf("s3: decode key of record %d: %w", i, err)
+		}
+		records = append(records, S3EventRecord{
+			Bucket:    r.S3.Bucket.Name,
+			Key:       key,
+			Size:      r.S3.Object.Size,
+			ETag:      r.S3.Object.ETag,
+			EventName: r.EventName,
+			EventTime: r.EventTime,
+		})
+	}
+	return records, nil
+}
@@ -0,0 +1,78 @@
+package s3
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseS3Event(t *testing.T) {
+	body, err := os.ReadFile("testdata/s3-put-event.json")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	records, err := ParseS3Event(body)
+	if err != nil {
+		t.Fatalf("ParseS3Event() error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("len(records) = %d, want 2", len(records))
+	}
+
+	want := S3EventRecord{
+		Bucket:    "my-media-bucket",
+		Key:       "images/my photo.jpg",
+		Size:      1024,
+		ETag:      "d41d8cd98f00b204e9800998ecf8427e",
+		EventName: "ObjectCreated:Put",
+		EventTime: time.Date(2025, 12, 19, 8, 30, 15, 123000000, time.UTC),
+	}
+	got := records[0]
+	if got.Bucket != want.Bucket || got.Key != want.Key || got.Size != want.Size ||
+		got.ETag != want.ETag || got.EventName != want.EventName || !got.EventTime.Equal(want.EventTime) {
+		t.Errorf("records[0] = %+v, want %+v", got, want)
+	}
+
+	// 刪除事件：非 ASCII 與編碼後的 + 皆正確解碼，沒有 size
+	del := records[1]
+	if del.Key != "docs/報告 2025+.pdf" {
+		t.Errorf("records[1].Key = %q, want %q", del.Key, "docs/報告 2025+.pdf")
+	}
+	if del.EventName != "ObjectRemoved:Delete" || del.Size != 0 {
+		t.Errorf("records[1] = %+v", del)
+	}
+}
+
+func TestParseS3Event_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{"malformed_json", `{"Records": [`, "s3: parse event"},
+		{"not_json", `hello`, "s3: parse event"},
+		{"invalid_time", `{"Records":[{"eventTime":"yesterday"}]}`, "s3: parse event"},
+		{"invalid_key_encoding", `{"Records":[{"s3":{"object":{"key":"bad%zzkey"}}}]}`, "decode key of record 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseS3Event([]byte(tt.body))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseS3Event() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseS3Event_NoRecords(t *testing.T) {
+	records, err := ParseS3Event([]byte(`{}`))
+	if err != nil {
+		t.Fatalf("ParseS3Event() error: %v", err)
+	}
+	if records == nil || len(records) != 0 {
+		t.Errorf("records = %v, want empty slice", records)
+	}
+}
@@ -0,0 +1,104 @@
+package s3
+
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+// SanitizeS3Key 清理 S3 object key 中容易造成 SDK 或 CDN 問題的字元：
+//  1. 移除控制字元（U+0000–U+001F）
+//  2. 將反斜線 \ 轉為 /
+//  3. 將連續的 / 折疊為單一 /
+//  4. 移除開頭的 /
+//
+// 已清理過的 key 再次呼叫結果不變（idempotent）。
+func SanitizeS3Key(key string) string {
+	var b strings.Builder
+	b.Grow(len(key))
+
+	lastWasSlash := false
+	for _, r := range key {
+		if r <= 0x1F {
+			continue // 移除控制字元
+		}
+		if r == '\\' {
+			r = '/'
+		}
+		if r == '/' {
+			// 開頭或連續的 / 皆略過
+			if lastWasSlash || b.Len() == 0 {
+				lastWasSlash = true
+				continue
+			}
+			lastWasSlash = true
+		} else {
+			lastWasSlash = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// DateShardedKey 以日期分片建立 object key：prefix/YYYY/MM/DD/filename，
+// 將物件分散在不同前綴以避免 S3 hot-spot。日期以 UTC 計算，確保各時區的服務結果一致。
+// prefix 為空時不會產生開頭的 /；filename 可包含子路徑（開頭的 / 會被移除）。
+//
+//	key := s3.DateShardedKey("uploads", "photo.jpg", t)
+//	// "uploads/2025/12/19/photo.jpg"
+func DateShardedKey(prefix, filename string, t time.Time) string {
+	return BuildDatePrefix(prefix, t) + strings.TrimLeft(filename, "/")
+}
+
+// HourShardedKey 同 DateShardedKey，但再加上小時分片：prefix/YYYY/MM/DD/HH/filename。
+//
+//	key := s3.HourShardedKey("logs", "app.log", t)
+//	// "logs/2025/12/19/08/app.log"
+func HourShardedKey(prefix, filename string, t time.Time) string {
+	return BuildHourPrefix(prefix, t) + strings.TrimLeft(filename, "/")
+}
+
+// VariantKey 在原始 key 的檔名前插入衍生資源的路徑片段 variant，
+// 用於以可預測路徑存放縮圖、轉檔等衍生物件。variant 前後的 / 會被移除，為空時回傳原始 key。
+//
+//	key := s3.VariantKey("images/photo.jpg", "webp")
+//	// "images/webp/photo.jpg"
+func VariantKey(originalKey, variant string) string {
+	variant = strings.Trim(variant, "/")
+	if variant == "" {
+		return originalKey
+	}
+	dir, file := path.Split(originalKey)
+	return dir + variant + "/" + file
+}
+
+// ThumbnailKey 建立縮圖的 object key：在檔名前插入 thumbs/<size>/。
+// size 為空時僅插入 thumbs/。
+//
+//	key := s3.ThumbnailKey("images/photo.jpg", "300x300")
+//	// "images/thumbs/300x300/photo.jpg"
+func ThumbnailKey(originalKey, size string) string {
+	return VariantKey(originalKey, BuildPrefix("thumbs", size))
+}
+
+// VersionedKey 在副檔名前插入版本號，用於 CDN cache-busting：style.css + v3 → style.v3.css。
+// 多個點的檔名只在最後一個副檔名前插入（app.min.js → app.min.v3.js）；
+// 沒有副檔名（含 .env 這類點開頭的檔名）時附加在最後（LICENSE → LICENSE.v3）。
+// version 為空時回傳原始 key。
+func VersionedKey(key, version string) string {
+	if version == "" {
+		return key
+	}
+	dir, file := path.Split(key)
+	ext := path.Ext(file)
+	if ext == file {
+		ext = "" // 點開頭的檔名視為沒有副檔名
+	}
+	return dir + strings.TrimSuffix(file, ext) + "." + version + ext
+}
+
+// HashedKey 同 VersionedKey，但以內容雜湊（例如 MD5/SHA 的前幾碼）作為版本：
+// app.js + 3f2a9c1b → app.3f2a9c1b.js。內容不變時 key 不變，可安全設定長時間快取。
+func HashedKey(key, hash string) string {
+	return VersionedKey(key, hash)
+}
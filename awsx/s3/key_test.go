@@ -0,0 +1,184 @@
+package s3
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSanitizeS3Key(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{"backslashes", `uploads\2025\photo.jpg`, "uploads/2025/photo.jpg"},
+		{"consecutive_slashes", "uploads//2025///photo.jpg", "uploads/2025/photo.jpg"},
+		{"leading_slash", "/uploads/photo.jpg", "uploads/photo.jpg"},
+		{"control_chars", "uploads/\x00pho\nto\t.jpg\x1f", "uploads/photo.jpg"},
+		{"worst_case", "\x01/\\/uploads\\\\2025//\x0012/\r\nphoto.jpg", "uploads/2025/12/photo.jpg"},
+		{"already_clean", "uploads/2025/12/photo.jpg", "uploads/2025/12/photo.jpg"},
+		{"only_backslashes", `\\\\`, ""},
+		{"trailing_slash_kept", "uploads/2025/", "uploads/2025/"},
+		{"unicode_kept", "上傳/照片.jpg", "上傳/照片.jpg"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SanitizeS3Key(tt.key)
+			if got != tt.want {
+				t.Errorf("SanitizeS3Key(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+			// idempotent
+			if again := SanitizeS3Key(got); again != got {
+				t.Errorf("SanitizeS3Key not idempotent: %q -> %q", got, again)
+			}
+		})
+	}
+}
+
+func TestDateShardedKey(t *testing.T) {
+	taipei := time.FixedZone("UTC+8", 8*3600)
+	ts := time.Date(2025, 12, 19, 8, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		prefix   string
+		filename string
+		t        time.Time
+		want     string
+	}{
+		{"normal", "uploads", "photo.jpg", ts, "uploads/2025/12/19/photo.jpg"},
+		{"prefix_slashes", "/uploads/", "photo.jpg", ts, "uploads/2025/12/19/photo.jpg"},
+		{"empty_prefix", "", "photo.jpg", ts, "2025/12/19/photo.jpg"},
+		{"filename_with_slash", "uploads", "user/42/photo.jpg", ts, "uploads/2025/12/19/user/42/photo.jpg"},
+		{"filename_leading_slash", "uploads", "/photo.jpg", ts, "uploads/2025/12/19/photo.jpg"},
+		{"utc_midnight", "uploads", "a.txt", time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC), "uploads/2025/12/19/a.txt"},
+		{"before_utc_midnight", "uploads", "a.txt", time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC), "uploads/2025/12/31/a.txt"},
+		// 台北零點 = 前一天 UTC 16:00，以 UTC 日期分片
+		{"local_midnight_uses_utc", "uploads", "a.txt", time.Date(2026, 1, 1, 0, 0, 0, 0, taipei), "uploads/2025/12/31/a.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DateShardedKey(tt.prefix, tt.filename, tt.t); got != tt.want {
+				t.Errorf("DateShardedKey(%q, %q, %v) = %q, want %q", tt.prefix, tt.filename, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHourShardedKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		filename string
+		t        time.Time
+		want     string
+	}{
+		{"normal", "logs", "app.log", time.Date(2025, 12, 19, 8, 30, 0, 0, time.UTC), "logs/2025/12/19/08/app.log"},
+		{"empty_prefix", "", "app.log", time.Date(2025, 12, 19, 8, 30, 0, 0, time.UTC), "2025/12/19/08/app.log"},
+		{"filename_with_slash", "logs", "svc/app.log", time.Date(2025, 12, 19, 8, 30, 0, 0, time.UTC), "logs/2025/12/19/08/svc/app.log"},
+		{"midnight", "logs", "app.log", time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC), "logs/2025/12/19/00/app.log"},
+		{"last_hour", "logs", "app.log", time.Date(2025, 12, 19, 23, 59, 59, 0, time.UTC), "logs/2025/12/19/23/app.log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HourShardedKey(tt.prefix, tt.filename, tt.t); got != tt.want {
+				t.Errorf("HourShardedKey(%q, %q, %v) = %q, want %q", tt.prefix, tt.filename, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestThumbnailKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		size string
+		want string
+	}{
+		{"basic", "images/photo.jpg", "300x300", "images/thumbs/300x300/photo.jpg"},
+		{"deep_key", "tenant/1/images/2025/12/photo.jpg", "64x64", "tenant/1/images/2025/12/thumbs/64x64/photo.jpg"},
+		{"no_directory", "photo.jpg", "300x300", "thumbs/300x300/photo.jpg"},
+		{"dots_in_directory", "assets/v1.2/img.d/photo.final.jpg", "300x300", "assets/v1.2/img.d/thumbs/300x300/photo.final.jpg"},
+		{"empty_size", "images/photo.jpg", "", "images/thumbs/photo.jpg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ThumbnailKey(tt.key, tt.size); got != tt.want {
+				t.Errorf("ThumbnailKey(%q, %q) = %q, want %q", tt.key, tt.size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVariantKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		variant string
+		want    string
+	}{
+		{"basic", "videos/clip.mp4", "720p", "videos/720p/clip.mp4"},
+		{"nested_variant", "images/photo.jpg", "webp/large", "images/webp/large/photo.jpg"},
+		{"trim_slashes", "images/photo.jpg", "/webp/", "images/webp/photo.jpg"},
+		{"no_directory", "photo.jpg", "webp", "webp/photo.jpg"},
+		{"empty_variant", "images/photo.jpg", "", "images/photo.jpg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VariantKey(tt.key, tt.variant); got != tt.want {
+				t.Errorf("VariantKey(%q, %q) = %q, want %q", tt.key, tt.variant, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionedKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		version string
+		want    string
+	}{
+		{"basic", "style.css", "v3", "style.v3.css"},
+		{"with_directory", "assets/css/style.css", "v3", "assets/css/style.v3.css"},
+		{"multiple_dots", "js/app.min.js", "v3", "js/app.min.v3.js"},
+		{"no_extension", "docs/LICENSE", "v3", "docs/LICENSE.v3"},
+		{"dotfile", "config/.env", "v3", "config/.env.v3"},
+		{"dots_in_directory", "v1.2/assets/logo", "v3", "v1.2/assets/logo.v3"},
+		{"version_with_dot", "style.css", "1.2.0", "style.1.2.0.css"},
+		{"empty_version", "style.css", "", "style.css"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VersionedKey(tt.key, tt.version); got != tt.want {
+				t.Errorf("VersionedKey(%q, %q) = %q, want %q", tt.key, tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashedKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		hash string
+		want string
+	}{
+		{"static/app.js", "3f2a9c1b", "static/app.3f2a9c1b.js"},
+		{"static/app.min.js", "3f2a9c1b", "static/app.min.3f2a9c1b.js"},
+		{"static/font", "3f2a9c1b", "static/font.3f2a9c1b"},
+		{"static/app.js", "", "static/app.js"},
+	}
+
+	for _, tt := range tests {
+		if got := HashedKey(tt.key, tt.hash); got != tt.want {
+			t.Errorf("HashedKey(%q, %q) = %q, want %q", tt.key, tt.hash, got, tt.want)
+		}
+	}
+}
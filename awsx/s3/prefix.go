@@ -1,8 +1,14 @@
 package s3
 
-import "strings"
+import (
+	"strings"
+	"time"
+)
 
 // BuildS3Prefix 建立 S3 路徑前綴
+//
+// 注意：mediaPrefix 為空時會產生連續斜線（"bucket" + "" → "bucket//"），
+// 為相容性保留此行為；新程式碼請使用 BuildS3PrefixClean。
 func BuildS3Prefix(bucketPrefix, mediaPrefix string) string {
 
 	bucketPrefix = strings.TrimSuffix(bucketPrefix, "/")
@@ -10,6 +16,25 @@ func BuildS3Prefix(bucketPrefix, mediaPrefix string) string {
 	return bucketPrefix + "/" + mediaPrefix + "/"
 }
 
+// BuildS3PrefixClean 與 BuildS3Prefix 相同，但會略過空白或僅含空白的片段，不會產生連續斜線：
+//
+//	s3.BuildS3PrefixClean("bucket", "media") // "bucket/media/"
+//	s3.BuildS3PrefixClean("bucket", "")      // "bucket/"
+//	s3.BuildS3PrefixClean("", " ")           // ""（bucket 根目錄）
+func BuildS3PrefixClean(bucketPrefix, mediaPrefix string) string {
+	var cleaned []string
+	for _, p := range []string{bucketPrefix, mediaPrefix} {
+		p = strings.Trim(strings.TrimSpace(p), "/")
+		if p != "" {
+			cleaned = append(cleaned, p)
+		}
+	}
+	if len(cleaned) == 0 {
+		return ""
+	}
+	return strings.Join(cleaned, "/") + "/"
+}
+
 // BuildPrefix 建立路徑前綴
 func BuildPrefix(parts ...string) string {
 	var cleaned []string
@@ -21,3 +46,53 @@ func BuildPrefix(parts ...string) string {
 	}
 	return strings.Join(cleaned, "/") + "/"
 }
+
+// BuildDatePrefix 建立以日期分區的前綴：base/YYYY/MM/DD/（data lake 常見的目錄結構）。
+// 預設以 UTC 計算日期，可選擇傳入 loc 改用指定時區（nil 視為 UTC）；base 前後的 / 會被移除。
+//
+//	prefix := s3.BuildDatePrefix("uploads", t)
+//	// prefix = "uploads/2025/12/19/"
+func BuildDatePrefix(base string, t time.Time, loc ...*time.Location) string {
+	return BuildPrefix(base, partitionTime(t, loc).Format("2006/01/02"))
+}
+
+// BuildHourPrefix 同 BuildDatePrefix，但再加上小時分區：base/YYYY/MM/DD/HH/。
+//
+//	prefix := s3.BuildHourPrefix("logs", t)
+//	// prefix = "logs/2025/12/19/08/"
+func BuildHourPrefix(base string, t time.Time, loc ...*time.Location) string {
+	return BuildPrefix(base, partitionTime(t, loc).Format("2006/01/02/15"))
+}
+
+// partitionTime 將 t 轉換至分區使用的時區：未指定或為 nil 時使用 UTC。
+func partitionTime(t time.Time, loc []*time.Location) time.Time {
+	if len(loc) > 0 && loc[0] != nil {
+		return t.In(loc[0])
+	}
+	return t.UTC()
+}
+
+// SplitKey 為 BuildPrefix 的反向操作：以 / 切分 key 並捨棄空片段（開頭、結尾與連續的 /）。
+//
+//	parts := s3.SplitKey("/uploads//2025/photo.jpg")
+//	// parts = []string{"uploads", "2025", "photo.jpg"}
+func SplitKey(key string) []string {
+	parts := strings.Split(key, "/")
+	cleaned := parts[:0]
+	for _, p := range parts {
+		if p != "" {
+			cleaned = append(cleaned, p)
+		}
+	}
+	return cleaned
+}
+
+// KeyFileName 回傳 key 的最後一個片段（通常為檔名），規則同 SplitKey；key 沒有任何片段時回傳空字串。
+// 注意：以 / 結尾的前綴會回傳最後一層目錄名稱（"uploads/2025/" → "2025"）。
+func KeyFileName(key string) string {
+	parts := SplitKey(key)
+	if len(parts) == 0 {
+		return ""
+	}
+	return parts[len(parts)-1]
+}
@@ -1,6 +1,11 @@
 package s3
 
-import "testing"
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestBuildS3Prefix(t *testing.T) {
 	tests := []struct {
@@ -23,6 +28,38 @@ func TestBuildS3Prefix(t *testing.T) {
 	}
 }
 
+func TestBuildS3PrefixClean(t *testing.T) {
+	tests := []struct {
+		name         string
+		bucketPrefix string
+		mediaPrefix  string
+		want         string
+	}{
+		{"normal", "bucket", "media", "bucket/media/"},
+		{"with_slashes", "bucket/", "/media/", "bucket/media/"},
+		{"nested", "bucket/prefix", "media/images", "bucket/prefix/media/images/"},
+		{"empty_media", "bucket", "", "bucket/"},
+		{"whitespace_media", "bucket", "  ", "bucket/"},
+		{"slash_media", "bucket/", "/", "bucket/"},
+		{"empty_bucket", "", "media", "media/"},
+		{"whitespace_bucket", " \t", "/media", "media/"},
+		{"all_empty", "", "", ""},
+		{"all_whitespace", " ", " ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildS3PrefixClean(tt.bucketPrefix, tt.mediaPrefix)
+			if got != tt.want {
+				t.Errorf("BuildS3PrefixClean(%q, %q) = %q, want %q", tt.bucketPrefix, tt.mediaPrefix, got, tt.want)
+			}
+			if strings.Contains(got, "//") {
+				t.Errorf("BuildS3PrefixClean(%q, %q) = %q, contains //", tt.bucketPrefix, tt.mediaPrefix, got)
+			}
+		})
+	}
+}
+
 func TestBuildPrefix(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -45,3 +82,107 @@ func TestBuildPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildDatePrefix(t *testing.T) {
+	taipei := time.FixedZone("UTC+8", 8*3600)
+	ts := time.Date(2025, 12, 19, 20, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		base string
+		loc  []*time.Location
+		want string
+	}{
+		{"known_timestamp", "uploads", nil, "uploads/2025/12/19/"},
+		{"trailing_slashes", "uploads//", nil, "uploads/2025/12/19/"},
+		{"leading_and_trailing_slashes", "/data/lake/", nil, "data/lake/2025/12/19/"},
+		{"empty_base", "", nil, "2025/12/19/"},
+		{"nil_location_uses_utc", "uploads", []*time.Location{nil}, "uploads/2025/12/19/"},
+		// UTC 20:30 = 台北隔天 04:30
+		{"with_location", "uploads", []*time.Location{taipei}, "uploads/2025/12/20/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildDatePrefix(tt.base, ts, tt.loc...); got != tt.want {
+				t.Errorf("BuildDatePrefix(%q, %v) = %q, want %q", tt.base, ts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildHourPrefix(t *testing.T) {
+	taipei := time.FixedZone("UTC+8", 8*3600)
+	ts := time.Date(2025, 12, 19, 8, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		base string
+		t    time.Time
+		loc  []*time.Location
+		want string
+	}{
+		{"known_timestamp", "logs", ts, nil, "logs/2025/12/19/08/"},
+		{"trailing_slashes", "logs/", ts, nil, "logs/2025/12/19/08/"},
+		{"local_input_uses_utc", "logs", ts.In(taipei), nil, "logs/2025/12/19/08/"},
+		{"with_location", "logs", ts, []*time.Location{taipei}, "logs/2025/12/19/16/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildHourPrefix(tt.base, tt.t, tt.loc...); got != tt.want {
+				t.Errorf("BuildHourPrefix(%q, %v) = %q, want %q", tt.base, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want []string
+	}{
+		{"normal", "uploads/2025/photo.jpg", []string{"uploads", "2025", "photo.jpg"}},
+		{"leading_trailing_slash", "/uploads/2025/", []string{"uploads", "2025"}},
+		{"doubled_slashes", "uploads//2025///photo.jpg", []string{"uploads", "2025", "photo.jpg"}},
+		{"root_level", "photo.jpg", []string{"photo.jpg"}},
+		{"empty", "", []string{}},
+		{"only_slashes", "///", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitKey(tt.key)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitKey(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+
+	// 與 BuildPrefix 互為反向
+	if got := BuildPrefix(SplitKey("/a//b/c/")...); got != "a/b/c/" {
+		t.Errorf("BuildPrefix(SplitKey()) = %q, want %q", got, "a/b/c/")
+	}
+}
+
+func TestKeyFileName(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"uploads/2025/photo.jpg", "photo.jpg"},
+		{"photo.jpg", "photo.jpg"},
+		{"/photo.jpg", "photo.jpg"},
+		{"uploads//photo.jpg", "photo.jpg"},
+		{"uploads/2025/", "2025"},
+		{"", ""},
+		{"/", ""},
+	}
+
+	for _, tt := range tests {
+		if got := KeyFileName(tt.key); got != tt.want {
+			t.Errorf("KeyFileName(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// S3URIScheme 為 S3 URI 的 scheme 前綴。
+const S3URIScheme = "s3://"
+
+var (
+	// ErrInvalidS3URI 表示 URI 不是 s3://bucket/key 格式。
+	ErrInvalidS3URI = errors.New("s3: invalid S3 URI")
+	// ErrInvalidBucketName 表示 bucket 名稱不符合命名規則。
+	ErrInvalidBucketName = errors.New("s3: invalid bucket name")
+)
+
+// ParseS3URI 解析 s3://bucket/path/to/key 格式的 URI（AWS CLI、SDK、Terraform 使用）。
+// 會驗證 scheme 與 bucket 名稱（見 IsValidBucketName），回傳的 key 不含開頭的 /；
+// 僅有 bucket 時 key 為空字串。
+//
+//	bucket, key, err := s3.ParseS3URI("s3://my-bucket/uploads/photo.jpg")
+//	// bucket = "my-bucket", key = "uploads/photo.jpg"
+func ParseS3URI(uri string) (bucket, key string, err error) {
+	rest, ok := strings.CutPrefix(uri, S3URIScheme)
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q must start with %s", ErrInvalidS3URI, uri, S3URIScheme)
+	}
+
+	bucket, key, _ = strings.Cut(rest, "/")
+	if !IsValidBucketName(bucket) {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidBucketName, bucket)
+	}
+	return bucket, strings.TrimLeft(key, "/"), nil
+}
+
+// BuildS3URI 為 ParseS3URI 的反向操作，建立 s3://bucket/key 格式的 URI。
+// key 開頭的 / 會被移除；key 為空時回傳 s3://bucket。
+func BuildS3URI(bucket, key string) string {
+	key = strings.TrimLeft(key, "/")
+	if key == "" {
+		return S3URIScheme + bucket
+	}
+	return S3URIScheme + bucket + "/" + key
+}
+
+// IsValidBucketName 驗證 bucket 名稱：長度 3–63，僅含小寫英數字與連字號 -，
+// 且開頭與結尾必須為英數字。
+func IsValidBucketName(name string) bool {
+	if len(name) < 3 || len(name) > 63 {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		case c == '-' && i > 0 && i < len(name)-1:
+		default:
+			return false
+		}
+	}
+	return true
+}
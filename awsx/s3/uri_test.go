@@ -0,0 +1,97 @@
+package s3
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseS3URI(t *testing.T) {
+	tests := []struct {
+		name       string
+		uri        string
+		wantBucket string
+		wantKey    string
+	}{
+		{"with_key", "s3://my-bucket/uploads/photo.jpg", "my-bucket", "uploads/photo.jpg"},
+		{"no_key", "s3://my-bucket", "my-bucket", ""},
+		{"trailing_slash", "s3://my-bucket/", "my-bucket", ""},
+		{"multiple_slashes", "s3://my-bucket/a/b/c/d.txt", "my-bucket", "a/b/c/d.txt"},
+		{"leading_slashes_in_key", "s3://my-bucket//a/b", "my-bucket", "a/b"},
+		{"prefix_key", "s3://logs-2025/app/", "logs-2025", "app/"},
+		{"nested_prefixes", "s3://data-lake/raw/2025/12/19/events.json.gz", "data-lake", "raw/2025/12/19/events.json.gz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucket, key, err := ParseS3URI(tt.uri)
+			if err != nil {
+				t.Fatalf("ParseS3URI(%q) error: %v", tt.uri, err)
+			}
+			if bucket != tt.wantBucket || key != tt.wantKey {
+				t.Errorf("ParseS3URI(%q) = (%q, %q), want (%q, %q)", tt.uri, bucket, key, tt.wantBucket, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestParseS3URI_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		wantErr error
+	}{
+		{"https_scheme", "https://my-bucket/key", ErrInvalidS3URI},
+		{"s3a_scheme", "s3a://my-bucket/key", ErrInvalidS3URI},
+		{"no_scheme", "my-bucket/key", ErrInvalidS3URI},
+		{"single_slash", "s3:/my-bucket/key", ErrInvalidS3URI},
+		{"missing_slashes", "s3:my-bucket/key", ErrInvalidS3URI},
+		{"uppercase_scheme", "S3://my-bucket/key", ErrInvalidS3URI},
+		{"empty", "", ErrInvalidS3URI},
+		{"scheme_only", "s3://", ErrInvalidBucketName},
+		{"empty_bucket", "s3:///key", ErrInvalidBucketName},
+		{"too_short", "s3://ab/key", ErrInvalidBucketName},
+		{"too_long", "s3://" + string(make([]byte, 64)) + "/key", ErrInvalidBucketName},
+		{"uppercase", "s3://My-Bucket/key", ErrInvalidBucketName},
+		{"underscore", "s3://my_bucket/key", ErrInvalidBucketName},
+		{"leading_hyphen", "s3://-bucket/key", ErrInvalidBucketName},
+		{"trailing_hyphen", "s3://bucket-/key", ErrInvalidBucketName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ParseS3URI(tt.uri)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseS3URI(%q) error = %v, want %v", tt.uri, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildS3URI(t *testing.T) {
+	tests := []struct {
+		name   string
+		bucket string
+		key    string
+		want   string
+	}{
+		{"with_key", "my-bucket", "uploads/photo.jpg", "s3://my-bucket/uploads/photo.jpg"},
+		{"leading_slash", "my-bucket", "/uploads/photo.jpg", "s3://my-bucket/uploads/photo.jpg"},
+		{"no_key", "my-bucket", "", "s3://my-bucket"},
+		{"from_prefix", "my-bucket", BuildPrefix("raw", "2025", "12") + "a.json", "s3://my-bucket/raw/2025/12/a.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildS3URI(tt.bucket, tt.key)
+			if got != tt.want {
+				t.Errorf("BuildS3URI(%q, %q) = %q, want %q", tt.bucket, tt.key, got, tt.want)
+			}
+
+			// round trip
+			bucket, key, err := ParseS3URI(got)
+			if err != nil || bucket != tt.bucket || key != SanitizeS3Key(tt.key) {
+				t.Errorf("ParseS3URI(%q) = (%q, %q, %v)", got, bucket, key, err)
+			}
+		})
+	}
+}
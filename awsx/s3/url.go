@@ -0,0 +1,26 @@
+package s3
+
+import "strings"
+
+// CloudFrontURL 建立 CloudFront distribution 預設網域的 HTTPS URL：
+// https://<distribution>.cloudfront.net/<key>。
+// key 開頭的 / 會被移除以避免產生 //；key 原樣保留（例如 "a.jpg?v=2" 的 query string），不做跳脫。
+//
+//	url := s3.CloudFrontURL("d111111abcdef8", "images/photo.jpg")
+//	// "https://d111111abcdef8.cloudfront.net/images/photo.jpg"
+func CloudFrontURL(distribution, key string) string {
+	return CloudFrontDomainURL(distribution+".cloudfront.net", key)
+}
+
+// CloudFrontDomainURL 同 CloudFrontURL，但使用自訂網域（例如 cdn.example.com）。
+// domain 可包含 https:// 或 http:// 前綴與結尾的 /，一律輸出 HTTPS URL。
+// key 為空時回傳網域根路徑（結尾為 /）。
+//
+//	url := s3.CloudFrontDomainURL("cdn.example.com", "/images/photo.jpg")
+//	// "https://cdn.example.com/images/photo.jpg"
+func CloudFrontDomainURL(domain, key string) string {
+	domain = strings.TrimPrefix(domain, "https://")
+	domain = strings.TrimPrefix(domain, "http://")
+	domain = strings.TrimRight(domain, "/")
+	return "https://" + domain + "/" + strings.TrimLeft(key, "/")
+}
@@ -0,0 +1,49 @@
+package s3
+
+import "testing"
+
+func TestCloudFrontURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		distribution string
+		key          string
+		want         string
+	}{
+		{"basic", "d111111abcdef8", "images/photo.jpg", "https://d111111abcdef8.cloudfront.net/images/photo.jpg"},
+		{"leading_slash", "d111111abcdef8", "/images/photo.jpg", "https://d111111abcdef8.cloudfront.net/images/photo.jpg"},
+		{"query_string", "d111111abcdef8", "images/photo.jpg?v=2&w=300", "https://d111111abcdef8.cloudfront.net/images/photo.jpg?v=2&w=300"},
+		{"empty_key", "d111111abcdef8", "", "https://d111111abcdef8.cloudfront.net/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CloudFrontURL(tt.distribution, tt.key); got != tt.want {
+				t.Errorf("CloudFrontURL(%q, %q) = %q, want %q", tt.distribution, tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloudFrontDomainURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		domain string
+		key    string
+		want   string
+	}{
+		{"basic", "cdn.example.com", "images/photo.jpg", "https://cdn.example.com/images/photo.jpg"},
+		{"no_double_slash", "cdn.example.com/", "/images/photo.jpg", "https://cdn.example.com/images/photo.jpg"},
+		{"https_prefix", "https://cdn.example.com", "a.jpg", "https://cdn.example.com/a.jpg"},
+		{"http_prefix_upgraded", "http://cdn.example.com", "a.jpg", "https://cdn.example.com/a.jpg"},
+		{"query_string", "cdn.example.com", "a.jpg?v=1", "https://cdn.example.com/a.jpg?v=1"},
+		{"empty_key", "cdn.example.com", "", "https://cdn.example.com/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CloudFrontDomainURL(tt.domain, tt.key); got != tt.want {
+				t.Errorf("CloudFrontDomainURL(%q, %q) = %q, want %q", tt.domain, tt.key, got, tt.want)
+			}
+		})
+	}
+}
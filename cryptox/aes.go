@@ -0,0 +1,75 @@
+package cryptox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// AESGCMKeySize 為 AESGCMEncrypt / AESGCMDecrypt 要求的金鑰長度（AES-256）。
+const AESGCMKeySize = 32
+
+// aesGCMNonceSize 為 GCM 標準的 nonce 長度。
+const aesGCMNonceSize = 12
+
+var (
+	// ErrInvalidKeySize 表示金鑰長度不是 AESGCMKeySize。
+	ErrInvalidKeySize = errors.New("cryptox: invalid AES-256 key size")
+	// ErrCiphertextTooShort 表示密文短於 nonce 加上驗證標籤的長度。
+	ErrCiphertextTooShort = errors.New("cryptox: ciphertext too short")
+	// ErrAuthenticationFailed 表示密文驗證失敗（遭竄改或金鑰錯誤）。
+	ErrAuthenticationFailed = errors.New("cryptox: message authentication failed")
+)
+
+// AESGCMEncrypt 以 AES-256-GCM 加密 plaintext（設定值、資料庫欄位等），key 必須為 32 bytes。
+// 每次呼叫產生隨機的 12-byte nonce 並置於密文前方，回傳 nonce || ciphertext || tag，
+// 因此相同明文每次加密的結果皆不同。
+//
+//	sealed, err := cryptox.AESGCMEncrypt([]byte(secret), key)
+//	plain, err := cryptox.AESGCMDecrypt(sealed, key)
+func AESGCMEncrypt(plaintext, key []byte) ([]byte, error) {
+	gcm, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aesGCMNonceSize, aesGCMNonceSize+len(plaintext)+gcm.Overhead())
+	if _, err := io.ReadFull(randReader, nonce); err != nil {
+		return nil, fmt.Errorf("cryptox: generate nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// AESGCMDecrypt 解密 AESGCMEncrypt 的輸出：取出前 12 bytes 作為 nonce 後解密並驗證。
+// 密文遭竄改或金鑰錯誤時回傳 ErrAuthenticationFailed，
+// 長度不足時回傳 ErrCiphertextTooShort，金鑰長度錯誤時回傳 ErrInvalidKeySize。
+func AESGCMDecrypt(ciphertext, key []byte) ([]byte, error) {
+	gcm, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aesGCMNonceSize+gcm.Overhead() {
+		return nil, ErrCiphertextTooShort
+	}
+
+	nonce, sealed := ciphertext[:aesGCMNonceSize], ciphertext[aesGCMNonceSize:]
+	plain, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, ErrAuthenticationFailed
+	}
+	return plain, nil
+}
+
+// newAESGCM 以 key 建立 AES-256-GCM AEAD。
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != AESGCMKeySize {
+		return nil, fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidKeySize, len(key), AESGCMKeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("cryptox: aes: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,107 @@
+package cryptox
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func testAESKey() []byte {
+	return bytes.Repeat([]byte{0x42}, AESGCMKeySize)
+}
+
+func TestAESGCM_RoundTrip(t *testing.T) {
+	key := testAESKey()
+
+	tests := []struct {
+		name      string
+		plaintext []byte
+	}{
+		{"text", []byte("db-password: s3cr3t")},
+		{"empty", []byte{}},
+		{"binary", []byte{0x00, 0xff, 0x10, 0x80}},
+		{"large", bytes.Repeat([]byte("x"), 64*1024)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sealed, err := AESGCMEncrypt(tt.plaintext, key)
+			if err != nil {
+				t.Fatalf("AESGCMEncrypt() error: %v", err)
+			}
+			if want := 12 + len(tt.plaintext) + 16; len(sealed) != want {
+				t.Errorf("len(ciphertext) = %d, want %d", len(sealed), want)
+			}
+
+			got, err := AESGCMDecrypt(sealed, key)
+			if err != nil {
+				t.Fatalf("AESGCMDecrypt() error: %v", err)
+			}
+			if !bytes.Equal(got, tt.plaintext) {
+				t.Errorf("AESGCMDecrypt() = %q, want %q", got, tt.plaintext)
+			}
+		})
+	}
+}
+
+func TestAESGCMEncrypt_RandomNonce(t *testing.T) {
+	key := testAESKey()
+	plaintext := []byte("same input")
+
+	a, err := AESGCMEncrypt(plaintext, key)
+	if err != nil {
+		t.Fatalf("AESGCMEncrypt() error: %v", err)
+	}
+	b, err := AESGCMEncrypt(plaintext, key)
+	if err != nil {
+		t.Fatalf("AESGCMEncrypt() error: %v", err)
+	}
+	if bytes.Equal(a, b) {
+		t.Error("two encryptions of the same plaintext are identical")
+	}
+	if bytes.Equal(a[:12], b[:12]) {
+		t.Error("two encryptions reused the same nonce")
+	}
+}
+
+func TestAESGCMDecrypt_Tampered(t *testing.T) {
+	key := testAESKey()
+	sealed, err := AESGCMEncrypt([]byte("transfer $100"), key)
+	if err != nil {
+		t.Fatalf("AESGCMEncrypt() error: %v", err)
+	}
+
+	// 分別竄改 nonce、密文與驗證標籤中的一個 byte
+	for _, i := range []int{0, 12, len(sealed) - 1} {
+		tampered := bytes.Clone(sealed)
+		tampered[i] ^= 0x01
+		if _, err := AESGCMDecrypt(tampered, key); !errors.Is(err, ErrAuthenticationFailed) {
+			t.Errorf("tampered byte %d: error = %v, want ErrAuthenticationFailed", i, err)
+		}
+	}
+
+	wrongKey := bytes.Repeat([]byte{0x43}, AESGCMKeySize)
+	if _, err := AESGCMDecrypt(sealed, wrongKey); !errors.Is(err, ErrAuthenticationFailed) {
+		t.Errorf("wrong key: error = %v, want ErrAuthenticationFailed", err)
+	}
+}
+
+func TestAESGCM_InvalidKey(t *testing.T) {
+	for _, n := range []int{0, 16, 24, 31, 33, 64} {
+		key := make([]byte, n)
+		if _, err := AESGCMEncrypt([]byte("x"), key); !errors.Is(err, ErrInvalidKeySize) {
+			t.Errorf("AESGCMEncrypt(key len %d) error = %v, want ErrInvalidKeySize", n, err)
+		}
+		if _, err := AESGCMDecrypt(make([]byte, 64), key); !errors.Is(err, ErrInvalidKeySize) {
+			t.Errorf("AESGCMDecrypt(key len %d) error = %v, want ErrInvalidKeySize", n, err)
+		}
+	}
+}
+
+func TestAESGCMDecrypt_TooShort(t *testing.T) {
+	for _, n := range []int{0, 11, 27} {
+		if _, err := AESGCMDecrypt(make([]byte, n), testAESKey()); !errors.Is(err, ErrCiphertextTooShort) {
+			t.Errorf("AESGCMDecrypt(len %d) error = %v, want ErrCiphertextTooShort", n, err)
+		}
+	}
+}
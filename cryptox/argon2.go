@@ -0,0 +1,118 @@
+package cryptox
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2Params 為 Argon2id 的參數。Memory 以 KiB 為單位。
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params 為 OWASP 建議的 Argon2id 最低參數（m=19 MiB、t=2、p=1），
+// 搭配 16-byte salt 與 32-byte 雜湊。
+var DefaultArgon2Params = Argon2Params{
+	Memory:      19 * 1024,
+	Iterations:  2,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+var (
+	// ErrInvalidArgon2Params 表示 Argon2Params 有欄位為 0。
+	ErrInvalidArgon2Params = errors.New("cryptox: invalid argon2 parameters")
+	// ErrInvalidArgon2Hash 表示編碼後的雜湊不是合法的 Argon2id PHC 字串。
+	ErrInvalidArgon2Hash = errors.New("cryptox: invalid argon2id hash")
+	// ErrIncompatibleArgon2Version 表示雜湊的 Argon2 版本與目前實作不同。
+	ErrIncompatibleArgon2Version = errors.New("cryptox: incompatible argon2 version")
+)
+
+// Argon2IDHash 以 Argon2id 雜湊密碼（OWASP 建議的密碼儲存演算法），每次產生隨機 salt，
+// 回傳 PHC 格式字串，參數與 salt 皆包含在內，驗證時不需另外保存：
+//
+//	$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+//
+// salt 與 hash 以不帶 padding 的標準 base64 編碼。params 有欄位為 0 時回傳 ErrInvalidArgon2Params。
+//
+//	encoded, err := cryptox.Argon2IDHash(password, cryptox.DefaultArgon2Params)
+func Argon2IDHash(password string, params Argon2Params) (string, error) {
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 ||
+		params.SaltLength == 0 || params.KeyLength == 0 {
+		return "", fmt.Errorf("%w: %+v", ErrInvalidArgon2Params, params)
+	}
+
+	salt := make([]byte, params.SaltLength)
+	if _, err := io.ReadFull(randReader, salt); err != nil {
+		return "", fmt.Errorf("cryptox: generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Argon2IDVerify 解析 Argon2IDHash 產生的 PHC 字串，以其中的參數與 salt 重新計算並以常數時間比較。
+// 密碼不符時回傳 false, nil；encodedHash 格式錯誤時回傳 ErrInvalidArgon2Hash，
+// 版本不同時回傳 ErrIncompatibleArgon2Version。
+func Argon2IDVerify(password, encodedHash string) (bool, error) {
+	params, salt, key, err := decodeArgon2ID(encodedHash)
+	if err != nil {
+		return false, err
+	}
+	got := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	return subtle.ConstantTimeCompare(got, key) == 1, nil
+}
+
+// decodeArgon2ID 解析 $argon2id$v=..$m=..,t=..,p=..$salt$hash。
+func decodeArgon2ID(encoded string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+
+	// 開頭的 $ 使第一個片段為空字串
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return params, nil, nil, ErrInvalidArgon2Hash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, fmt.Errorf("%w: version: %v", ErrInvalidArgon2Hash, err)
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("%w: got %d, want %d", ErrIncompatibleArgon2Version, version, argon2.Version)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("%w: parameters: %v", ErrInvalidArgon2Hash, err)
+	}
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 {
+		return params, nil, nil, fmt.Errorf("%w: zero parameter", ErrInvalidArgon2Hash)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return params, nil, nil, fmt.Errorf("%w: salt", ErrInvalidArgon2Hash)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, fmt.Errorf("%w: hash", ErrInvalidArgon2Hash)
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
@@ -0,0 +1,108 @@
+package cryptox
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestArgon2ID_RoundTrip(t *testing.T) {
+	encoded, err := Argon2IDHash("correct horse battery staple", DefaultArgon2Params)
+	if err != nil {
+		t.Fatalf("Argon2IDHash() error: %v", err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=19456,t=2,p=1$") {
+		t.Errorf("encoded = %q, want OWASP parameters in PHC string", encoded)
+	}
+	if parts := strings.Split(encoded, "$"); len(parts) != 6 {
+		t.Fatalf("encoded has %d parts, want 6", len(parts))
+	}
+
+	ok, err := Argon2IDVerify("correct horse battery staple", encoded)
+	if err != nil || !ok {
+		t.Errorf("Argon2IDVerify(correct) = %v, %v, want true, nil", ok, err)
+	}
+	ok, err = Argon2IDVerify("wrong password", encoded)
+	if err != nil || ok {
+		t.Errorf("Argon2IDVerify(wrong) = %v, %v, want false, nil", ok, err)
+	}
+}
+
+func TestArgon2IDHash_RandomSalt(t *testing.T) {
+	params := Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 16}
+
+	a, err := Argon2IDHash("same", params)
+	if err != nil {
+		t.Fatalf("Argon2IDHash() error: %v", err)
+	}
+	b, err := Argon2IDHash("same", params)
+	if err != nil {
+		t.Fatalf("Argon2IDHash() error: %v", err)
+	}
+	if a == b {
+		t.Error("two hashes of the same password are identical")
+	}
+}
+
+func TestArgon2IDVerify_ReferenceVector(t *testing.T) {
+	// Argon2 參考實作 README 的範例：echo -n "password" | argon2 somesalt -id -t 2 -m 16
+	const encoded = "$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc"
+
+	ok, err := Argon2IDVerify("password", encoded)
+	if err != nil || !ok {
+		t.Errorf("Argon2IDVerify(reference) = %v, %v, want true, nil", ok, err)
+	}
+}
+
+func TestArgon2IDHash_InvalidParams(t *testing.T) {
+	base := Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 16}
+
+	tests := []struct {
+		name   string
+		modify func(p *Argon2Params)
+	}{
+		{"zero_memory", func(p *Argon2Params) { p.Memory = 0 }},
+		{"zero_iterations", func(p *Argon2Params) { p.Iterations = 0 }},
+		{"zero_parallelism", func(p *Argon2Params) { p.Parallelism = 0 }},
+		{"zero_salt", func(p *Argon2Params) { p.SaltLength = 0 }},
+		{"zero_key", func(p *Argon2Params) { p.KeyLength = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := base
+			tt.modify(&p)
+			if _, err := Argon2IDHash("pw", p); !errors.Is(err, ErrInvalidArgon2Params) {
+				t.Errorf("Argon2IDHash() error = %v, want ErrInvalidArgon2Params", err)
+			}
+		})
+	}
+}
+
+func TestArgon2IDVerify_Malformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		wantErr error
+	}{
+		{"empty", "", ErrInvalidArgon2Hash},
+		{"bcrypt_hash", "$2a$04$abcdefghijklmnopqrstuuNyS5ZJ2m6zX0myG0pdX4oYk0X1iO0ly", ErrInvalidArgon2Hash},
+		{"argon2i", "$argon2i$v=19$m=64,t=1,p=1$c29tZXNhbHQ$aGFzaA", ErrInvalidArgon2Hash},
+		{"missing_part", "$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ", ErrInvalidArgon2Hash},
+		{"bad_version", "$argon2id$v=x$m=64,t=1,p=1$c29tZXNhbHQ$aGFzaA", ErrInvalidArgon2Hash},
+		{"old_version", "$argon2id$v=16$m=64,t=1,p=1$c29tZXNhbHQ$aGFzaA", ErrIncompatibleArgon2Version},
+		{"bad_params", "$argon2id$v=19$m=64;t=1$c29tZXNhbHQ$aGFzaA", ErrInvalidArgon2Hash},
+		{"zero_params", "$argon2id$v=19$m=0,t=1,p=1$c29tZXNhbHQ$aGFzaA", ErrInvalidArgon2Hash},
+		{"bad_salt", "$argon2id$v=19$m=64,t=1,p=1$!!!$aGFzaA", ErrInvalidArgon2Hash},
+		{"empty_hash", "$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ$", ErrInvalidArgon2Hash},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := Argon2IDVerify("pw", tt.encoded)
+			if ok || !errors.Is(err, tt.wantErr) {
+				t.Errorf("Argon2IDVerify() = %v, %v, want false, %v", ok, err, tt.wantErr)
+			}
+		})
+	}
+}
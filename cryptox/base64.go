@@ -0,0 +1,43 @@
+package cryptox
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// Base64Encode 以標準 base64（RFC 4648，含 = padding）編碼 data。
+func Base64Encode(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// Base64Decode 解碼標準 base64（含 = padding）字串，格式錯誤時回傳包裝 base64.CorruptInputError 的錯誤。
+func Base64Decode(s string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("cryptox: base64 decode: %w", err)
+	}
+	return b, nil
+}
+
+// Base64URLEncode 以 URL-safe base64（- 與 _ 取代 + 與 /，含 = padding）編碼 data。
+func Base64URLEncode(data []byte) string {
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// Base64URLEncodeNoPad 以 URL-safe base64 編碼 data 且不含 = padding（JWT、URL 參數使用的格式）：
+//
+//	cryptox.Base64URLEncodeNoPad([]byte{0xfb, 0xff}) // "-_8"
+func Base64URLEncodeNoPad(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Base64URLDecode 解碼 URL-safe base64 字串，有無 = padding 皆可（可還原 Base64URLEncode 與 Base64URLEncodeNoPad 的輸出）。
+// 格式錯誤時回傳包裝 base64.CorruptInputError 的錯誤。
+func Base64URLDecode(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, fmt.Errorf("cryptox: base64url decode: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,88 @@
+package cryptox
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBase64RoundTrip(t *testing.T) {
+	inputs := [][]byte{
+		{},
+		[]byte("f"),
+		[]byte("fo"),
+		[]byte("foo"),
+		[]byte("hello, 世界"),
+		{0xfb, 0xff, 0xfe, 0x00}, // 標準編碼會產生 + 與 /
+	}
+
+	for _, in := range inputs {
+		std := Base64Encode(in)
+		if got, err := Base64Decode(std); err != nil || !bytes.Equal(got, in) {
+			t.Errorf("Base64Decode(Base64Encode(%q)) = %q, %v", in, got, err)
+		}
+
+		url := Base64URLEncode(in)
+		if strings.ContainsAny(url, "+/") {
+			t.Errorf("Base64URLEncode(%q) = %q, contains + or /", in, url)
+		}
+		if got, err := Base64URLDecode(url); err != nil || !bytes.Equal(got, in) {
+			t.Errorf("Base64URLDecode(Base64URLEncode(%q)) = %q, %v", in, got, err)
+		}
+
+		raw := Base64URLEncodeNoPad(in)
+		if strings.Contains(raw, "=") {
+			t.Errorf("Base64URLEncodeNoPad(%q) = %q, contains padding", in, raw)
+		}
+		if raw != strings.TrimRight(url, "=") {
+			t.Errorf("Base64URLEncodeNoPad(%q) = %q, want %q", in, raw, strings.TrimRight(url, "="))
+		}
+		if got, err := Base64URLDecode(raw); err != nil || !bytes.Equal(got, in) {
+			t.Errorf("Base64URLDecode(Base64URLEncodeNoPad(%q)) = %q, %v", in, got, err)
+		}
+	}
+}
+
+func TestBase64Vectors(t *testing.T) {
+	// RFC 4648 §10
+	tests := []struct {
+		in, std string
+	}{
+		{"", ""},
+		{"f", "Zg=="},
+		{"fo", "Zm8="},
+		{"foo", "Zm9v"},
+		{"foob", "Zm9vYg=="},
+		{"fooba", "Zm9vYmE="},
+		{"foobar", "Zm9vYmFy"},
+	}
+
+	for _, tt := range tests {
+		if got := Base64Encode([]byte(tt.in)); got != tt.std {
+			t.Errorf("Base64Encode(%q) = %q, want %q", tt.in, got, tt.std)
+		}
+		if got := Base64URLEncodeNoPad([]byte(tt.in)); got != strings.TrimRight(tt.std, "=") {
+			t.Errorf("Base64URLEncodeNoPad(%q) = %q", tt.in, got)
+		}
+	}
+	if got := Base64URLEncodeNoPad([]byte{0xfb, 0xff}); got != "-_8" {
+		t.Errorf("Base64URLEncodeNoPad(fb ff) = %q, want %q", got, "-_8")
+	}
+}
+
+func TestBase64DecodeErrors(t *testing.T) {
+	var corrupt base64.CorruptInputError
+
+	for _, in := range []string{"!!!!", "Zg=", "Zm9v Zg", "-_8="} {
+		if _, err := Base64Decode(in); !errors.As(err, &corrupt) {
+			t.Errorf("Base64Decode(%q) error = %v, want CorruptInputError", in, err)
+		}
+	}
+	for _, in := range []string{"!!!!", "+/8=", "Z", "Zm9v Zg"} {
+		if _, err := Base64URLDecode(in); !errors.As(err, &corrupt) {
+			t.Errorf("Base64URLDecode(%q) error = %v, want CorruptInputError", in, err)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package cryptox
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptDefaultCost 為 BcryptHashDefault 使用的 cost。
+const BcryptDefaultCost = 12
+
+// ErrInvalidBcryptCost 表示 bcrypt cost 超出 [bcrypt.MinCost, bcrypt.MaxCost]（4–31）。
+var ErrInvalidBcryptCost = errors.New("cryptox: invalid bcrypt cost")
+
+// BcryptHash 以 bcrypt 雜湊密碼（每次產生隨機 salt，相同密碼的結果皆不同），
+// cost 必須介於 4 到 31 之間，否則回傳 ErrInvalidBcryptCost。
+// bcrypt 只使用密碼的前 72 bytes，超過時回傳錯誤而非靜默截斷。
+func BcryptHash(password string, cost int) (string, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return "", fmt.Errorf("%w: %d (must be %d-%d)", ErrInvalidBcryptCost, cost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	h, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", fmt.Errorf("cryptox: bcrypt: %w", err)
+	}
+	return string(h), nil
+}
+
+// BcryptHashDefault 以 BcryptDefaultCost（12）雜湊密碼。
+func BcryptHashDefault(password string) (string, error) {
+	return BcryptHash(password, BcryptDefaultCost)
+}
+
+// BcryptVerify 回報 password 是否符合 bcrypt 雜湊 hash；hash 格式錯誤時回傳 false。
+//
+//	if !cryptox.BcryptVerify(input, user.PasswordHash) {
+//	    return ErrInvalidCredentials
+//	}
+func BcryptVerify(password, hash string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
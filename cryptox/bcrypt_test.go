@@ -0,0 +1,75 @@
+package cryptox
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBcryptHash_Verify(t *testing.T) {
+	h, err := BcryptHash("correct horse", 4)
+	if err != nil {
+		t.Fatalf("BcryptHash() error: %v", err)
+	}
+	if !strings.HasPrefix(h, "$2a$04$") {
+		t.Errorf("hash = %q, want $2a$04$ prefix", h)
+	}
+	if !BcryptVerify("correct horse", h) {
+		t.Error("BcryptVerify(correct) = false, want true")
+	}
+	if BcryptVerify("wrong horse", h) {
+		t.Error("BcryptVerify(wrong) = true, want false")
+	}
+	if BcryptVerify("", h) {
+		t.Error("BcryptVerify(empty) = true, want false")
+	}
+}
+
+func TestBcryptHash_RandomSalt(t *testing.T) {
+	a, err := BcryptHash("same password", 4)
+	if err != nil {
+		t.Fatalf("BcryptHash() error: %v", err)
+	}
+	b, err := BcryptHash("same password", 4)
+	if err != nil {
+		t.Fatalf("BcryptHash() error: %v", err)
+	}
+	if a == b {
+		t.Error("two hashes of the same password are identical")
+	}
+	if !BcryptVerify("same password", a) || !BcryptVerify("same password", b) {
+		t.Error("BcryptVerify() = false for a salted hash, want true")
+	}
+}
+
+func TestBcryptHashDefault(t *testing.T) {
+	h, err := BcryptHashDefault("pw")
+	if err != nil {
+		t.Fatalf("BcryptHashDefault() error: %v", err)
+	}
+	if !strings.HasPrefix(h, "$2a$12$") {
+		t.Errorf("hash = %q, want cost 12", h)
+	}
+}
+
+func TestBcryptHash_InvalidCost(t *testing.T) {
+	for _, cost := range []int{-1, 0, 3, 32, 100} {
+		if _, err := BcryptHash("pw", cost); !errors.Is(err, ErrInvalidBcryptCost) {
+			t.Errorf("BcryptHash(cost %d) error = %v, want ErrInvalidBcryptCost", cost, err)
+		}
+	}
+}
+
+func TestBcryptHash_TooLong(t *testing.T) {
+	if _, err := BcryptHash(strings.Repeat("a", 73), 4); err == nil {
+		t.Error("BcryptHash(73 bytes) error = nil, want error")
+	}
+}
+
+func TestBcryptVerify_InvalidHash(t *testing.T) {
+	for _, h := range []string{"", "not-a-hash", "$2a$", "$2a$04$short", "$2a$99$" + strings.Repeat("a", 53)} {
+		if BcryptVerify("pw", h) {
+			t.Errorf("BcryptVerify(%q) = true, want false", h)
+		}
+	}
+}
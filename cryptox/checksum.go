@@ -0,0 +1,71 @@
+package cryptox
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
+
+// FileChecksum 與 ReaderChecksum 支援的演算法名稱（不區分大小寫）。
+const (
+	ChecksumMD5    = "md5"
+	ChecksumSHA1   = "sha1"
+	ChecksumSHA256 = "sha256"
+	ChecksumSHA512 = "sha512"
+)
+
+// ErrUnknownChecksumAlgorithm 表示不支援的 checksum 演算法名稱。
+var ErrUnknownChecksumAlgorithm = errors.New("cryptox: unknown checksum algorithm")
+
+// FileChecksum 以串流方式計算檔案的 checksum（小寫十六進位），不會將檔案全部載入記憶體，
+// 用於驗證下載檔案的完整性：
+//
+//	sum, err := cryptox.FileChecksum("app.tar.gz", cryptox.ChecksumSHA256)
+//	if err == nil && sum != expected { ... }
+//
+// algorithm 不支援時回傳包裝 ErrUnknownChecksumAlgorithm 的錯誤（不會開啟檔案）。
+func FileChecksum(path, algorithm string) (string, error) {
+	h, err := newChecksumHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("cryptox: checksum: %w", err)
+	}
+	defer f.Close()
+	return hashReader(h, f)
+}
+
+// ReaderChecksum 同 FileChecksum，但讀取任意 io.Reader 直到 EOF。
+func ReaderChecksum(r io.Reader, algorithm string) (string, error) {
+	h, err := newChecksumHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+	return hashReader(h, r)
+}
+
+// newChecksumHash 依演算法名稱建立 hash.Hash。
+func newChecksumHash(algorithm string) (hash.Hash, error) {
+	switch strings.ToLower(algorithm) {
+	case ChecksumMD5:
+		return md5.New(), nil
+	case ChecksumSHA1:
+		return sha1.New(), nil
+	case ChecksumSHA256:
+		return sha256.New(), nil
+	case ChecksumSHA512:
+		return sha512.New(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownChecksumAlgorithm, algorithm)
+	}
+}
@@ -0,0 +1,68 @@
+package cryptox
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileChecksum(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt")
+	if err := os.WriteFile(path, []byte("hello world\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		algorithm string
+		want      string
+	}{
+		{ChecksumMD5, "6f5902ac237024bdd0c176cb93063dc4"},
+		{ChecksumSHA1, "22596363b3de40b06f981fb85d82312e8c0ed511"},
+		{ChecksumSHA256, "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"},
+		{ChecksumSHA512, "db3974a97f2407b7cae1ae637c0030687a11913274d578492558e39c16c017de84eacdc8c62fe34ee4e12b4b1428817f09b6a2760c3f8a664ceae94d2434a593"},
+		{"SHA256", "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			got, err := FileChecksum(path, tt.algorithm)
+			if err != nil {
+				t.Fatalf("FileChecksum() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FileChecksum(%q) = %q, want %q", tt.algorithm, got, tt.want)
+			}
+
+			got, err = ReaderChecksum(strings.NewReader("hello world\n"), tt.algorithm)
+			if err != nil {
+				t.Fatalf("ReaderChecksum() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ReaderChecksum(%q) = %q, want %q", tt.algorithm, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileChecksumErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt")
+	if err := os.WriteFile(path, []byte("data"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := FileChecksum(filepath.Join(t.TempDir(), "missing.txt"), ChecksumSHA256); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("missing file: error = %v, want fs.ErrNotExist", err)
+	}
+	if _, err := FileChecksum(path, "crc32"); !errors.Is(err, ErrUnknownChecksumAlgorithm) {
+		t.Errorf("unknown algorithm: error = %v, want ErrUnknownChecksumAlgorithm", err)
+	}
+	if _, err := ReaderChecksum(strings.NewReader("data"), ""); !errors.Is(err, ErrUnknownChecksumAlgorithm) {
+		t.Errorf("empty algorithm: error = %v, want ErrUnknownChecksumAlgorithm", err)
+	}
+	if _, err := ReaderChecksum(failingReader{}, ChecksumMD5); err == nil {
+		t.Error("expected error from failing reader")
+	}
+}
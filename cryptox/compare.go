@@ -0,0 +1,17 @@
+package cryptox
+
+import "crypto/subtle"
+
+// SecureEqual 以常數時間比較 a 與 b（crypto/subtle.ConstantTimeCompare），用於比較 HMAC 簽章、token 等秘密值。
+// 長度相同時不會在第一個不同的 byte 提早返回，執行時間與內容無關；
+// 長度不同時直接回傳 false，因此長度本身不視為秘密（簽章與 token 通常為固定長度）。
+func SecureEqual(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
+
+// SecureEqualString 同 SecureEqual，但比較字串：
+//
+//	if !cryptox.SecureEqualString(r.Header.Get("X-API-Key"), apiKey) { ... }
+func SecureEqualString(a, b string) bool {
+	return SecureEqual([]byte(a), []byte(b))
+}
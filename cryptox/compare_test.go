@@ -0,0 +1,34 @@
+package cryptox
+
+import "testing"
+
+func TestSecureEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"equal", "sha256-signature", "sha256-signature", true},
+		{"both_empty", "", "", true},
+		{"differ_first_byte", "xbc", "abc", false},
+		{"differ_last_byte", "abx", "abc", false},
+		{"different_length", "abc", "abcd", false},
+		{"prefix", "", "a", false},
+		{"case", "ABC", "abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SecureEqual([]byte(tt.a), []byte(tt.b)); got != tt.want {
+				t.Errorf("SecureEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := SecureEqualString(tt.a, tt.b); got != tt.want {
+				t.Errorf("SecureEqualString(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+
+	if !SecureEqual(nil, []byte{}) {
+		t.Error("SecureEqual(nil, []byte{}) = false, want true")
+	}
+}
@@ -0,0 +1,37 @@
+package cryptox
+
+import (
+	"fmt"
+	"hash/crc32"
+)
+
+// CRC32Poly 為 CRC32 的多項式（reversed 表示法，同 hash/crc32）。
+type CRC32Poly uint32
+
+// 常用的 CRC32 多項式。
+const (
+	// CRC32PolyIEEE 為最常用的多項式（gzip、zip、PNG、Ethernet）。
+	CRC32PolyIEEE CRC32Poly = crc32.IEEE
+	// CRC32PolyCastagnoli 為 CRC-32C（iSCSI、ext4、S3 的 x-amz-checksum-crc32c），支援硬體加速。
+	CRC32PolyCastagnoli CRC32Poly = crc32.Castagnoli
+)
+
+// CRC32HashIEEE 回傳 data 以 IEEE 多項式計算的 CRC32。
+// CRC32 僅用於偵測意外的資料損毀，無法防止刻意竄改。
+func CRC32HashIEEE(data []byte) uint32 {
+	return crc32.ChecksumIEEE(data)
+}
+
+// CRC32HashCastagnoli 回傳 data 以 Castagnoli 多項式計算的 CRC32（CRC-32C）。
+func CRC32HashCastagnoli(data []byte) uint32 {
+	return crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))
+}
+
+// CRC32HashString 回傳 data 以 poly 計算的 CRC32，格式為 8 個小寫十六進位字元（不足補 0）：
+//
+//	cryptox.CRC32HashString([]byte("123456789"), cryptox.CRC32PolyIEEE) // "cbf43926"
+//
+// IEEE 與 Castagnoli 的查表會被快取；其他多項式每次呼叫都會重新建立查表。
+func CRC32HashString(data []byte, poly CRC32Poly) string {
+	return fmt.Sprintf("%08x", crc32.Checksum(data, crc32.MakeTable(uint32(poly))))
+}
@@ -0,0 +1,60 @@
+package cryptox
+
+import (
+	"hash/crc32"
+	"regexp"
+	"testing"
+)
+
+func TestCRC32Hash(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         string
+		ieee       uint32
+		castagnoli uint32
+	}{
+		{"empty", "", 0x00000000, 0x00000000},
+		{"check", "123456789", 0xcbf43926, 0xe3069283},
+		{"single", "a", 0xe8b7be43, 0xc1d04330},
+		{"fox", "The quick brown fox jumps over the lazy dog", 0x414fa339, 0x22620404},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CRC32HashIEEE([]byte(tt.in)); got != tt.ieee {
+				t.Errorf("CRC32HashIEEE(%q) = %08x, want %08x", tt.in, got, tt.ieee)
+			}
+			if got := CRC32HashCastagnoli([]byte(tt.in)); got != tt.castagnoli {
+				t.Errorf("CRC32HashCastagnoli(%q) = %08x, want %08x", tt.in, got, tt.castagnoli)
+			}
+		})
+	}
+}
+
+func TestCRC32HashString(t *testing.T) {
+	hex8 := regexp.MustCompile(`^[0-9a-f]{8}$`)
+
+	tests := []struct {
+		name string
+		in   string
+		poly CRC32Poly
+		want string
+	}{
+		{"ieee", "123456789", CRC32PolyIEEE, "cbf43926"},
+		{"castagnoli", "123456789", CRC32PolyCastagnoli, "e3069283"},
+		{"zero_padded", "", CRC32PolyIEEE, "00000000"},
+		{"koopman", "123456789", crc32.Koopman, "2d3dd0ae"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CRC32HashString([]byte(tt.in), tt.poly)
+			if !hex8.MatchString(got) {
+				t.Errorf("CRC32HashString(%q) = %q, want 8 lowercase hex characters", tt.in, got)
+			}
+			if got != tt.want {
+				t.Errorf("CRC32HashString(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
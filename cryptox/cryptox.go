@@ -2,8 +2,13 @@ package cryptox
 
 import (
 	"crypto/md5"
+	"crypto/sha1"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
 )
 
 // MD5Hash 回傳字串的 MD5 雜湊
@@ -12,8 +17,48 @@ func MD5Hash(s string) string {
 	return hex.EncodeToString(h[:])
 }
 
+// SHA1Hash 回傳字串的 SHA1 雜湊（僅供舊系統整合，例如 Git object ID）
+func SHA1Hash(s string) string {
+	h := sha1.Sum([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
 // SHA256Hash 回傳字串的 SHA256 雜湊
 func SHA256Hash(s string) string {
 	h := sha256.Sum256([]byte(s))
 	return hex.EncodeToString(h[:])
 }
+
+// SHA512Hash 回傳字串的 SHA512 雜湊
+func SHA512Hash(s string) string {
+	h := sha512.Sum512([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+// MD5HashReader 以串流方式計算 r 的 MD5 雜湊，不會將內容全部載入記憶體
+func MD5HashReader(r io.Reader) (string, error) {
+	return hashReader(md5.New(), r)
+}
+
+// SHA1HashReader 以串流方式計算 r 的 SHA1 雜湊，不會將內容全部載入記憶體
+func SHA1HashReader(r io.Reader) (string, error) {
+	return hashReader(sha1.New(), r)
+}
+
+// SHA256HashReader 以串流方式計算 r 的 SHA256 雜湊（例如大型檔案的 checksum），不會將內容全部載入記憶體
+func SHA256HashReader(r io.Reader) (string, error) {
+	return hashReader(sha256.New(), r)
+}
+
+// SHA512HashReader 以串流方式計算 r 的 SHA512 雜湊，不會將內容全部載入記憶體
+func SHA512HashReader(r io.Reader) (string, error) {
+	return hashReader(sha512.New(), r)
+}
+
+// hashReader 將 r 讀到 EOF 並回傳十六進位雜湊；讀取失敗時回傳包裝後的錯誤。
+func hashReader(h hash.Hash, r io.Reader) (string, error) {
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("cryptox: hash reader: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
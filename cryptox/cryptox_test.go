@@ -1,6 +1,14 @@
 package cryptox
 
-import "testing"
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// nist448 為 FIPS 180 範例中的 448-bit 訊息。
+const nist448 = "abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq"
 
 func TestMD5Hash(t *testing.T) {
 	tests := []struct {
@@ -11,6 +19,7 @@ func TestMD5Hash(t *testing.T) {
 		{"empty", "", "d41d8cd98f00b204e9800998ecf8427e"},
 		{"hello", "hello", "5d41402abc4b2a76b9719d911017c592"},
 		{"test", "test", "098f6bcd4621d373cade4e832627b4f6"},
+		{"abc", "abc", "900150983cd24fb0d6963f7d28e17f72"},
 	}
 
 	for _, tt := range tests {
@@ -30,6 +39,8 @@ func TestSHA256Hash(t *testing.T) {
 	}{
 		{"empty", "", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
 		{"hello", "hello", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"},
+		{"nist_abc", "abc", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{"nist_448", nist448, "248d6a61d20638b8e5c026930c3e6039a33ce45964ff2167f6ecedd419db06c1"},
 	}
 
 	for _, tt := range tests {
@@ -40,3 +51,122 @@ func TestSHA256Hash(t *testing.T) {
 		})
 	}
 }
+
+func TestSHA1Hash(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", "da39a3ee5e6b4b0d3255bfef95601890afd80709"},
+		{"nist_abc", "abc", "a9993e364706816aba3e25717850c26c9cd0d89d"},
+		{"nist_448", nist448, "84983e441c3bd26ebaae4aa1f95129e5e54670f1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SHA1Hash(tt.in); got != tt.want {
+				t.Errorf("SHA1Hash(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSHA512Hash(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e"},
+		{"nist_abc", "abc", "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"},
+		{"nist_448", nist448, "204a8fc6dda82f0a0ced7beb8e08a41657c16ef468b228a8279be331a703c33596fd15c13b1b07f9aa1d3bea57789ca031ad85c7a71dd70354ec631238ca3445"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SHA512Hash(tt.in); got != tt.want {
+				t.Errorf("SHA512Hash(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+// repeatReader 產生 n 個 byte 的重複內容，模擬大型檔案而不需事先配置。
+type repeatReader struct {
+	b byte
+	n int
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	if r.n == 0 {
+		return 0, io.EOF
+	}
+	p = p[:min(len(p), r.n)]
+	for i := range p {
+		p[i] = r.b
+	}
+	r.n -= len(p)
+	return len(p), nil
+}
+
+func TestHashReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func(io.Reader) (string, error)
+		str     func(string) string
+		million string // NIST：一百萬個 'a'
+	}{
+		{"md5", MD5HashReader, MD5Hash, "7707d6ae4e027c70eea2a935c2296f21"},
+		{"sha1", SHA1HashReader, SHA1Hash, "34aa973cd4c4daa4f61eeb2bdbad27316534016f"},
+		{"sha256", SHA256HashReader, SHA256Hash, "cdc76e5c9914fb9281a1c7e284d73e67f1809a48a497200e046d39ccc7112cd0"},
+		{"sha512", SHA512HashReader, SHA512Hash, "e718483d0ce769644e2e42c7bc15b4638e1f98b13b2044285632a803afa973ebde0ff244877ea60a4cb0432ce577c31beb009c5c2c49aa2e4eadb217ad8cc09b"},
+	}
+
+	const large = 8 << 20 // 8 MiB
+	largeInput := strings.Repeat("x", large)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(&repeatReader{b: 'a', n: 1_000_000})
+			if err != nil {
+				t.Fatalf("one million 'a': unexpected error: %v", err)
+			}
+			if got != tt.million {
+				t.Errorf("one million 'a' = %q, want %q", got, tt.million)
+			}
+
+			got, err = tt.fn(&repeatReader{b: 'x', n: large})
+			if err != nil {
+				t.Fatalf("8 MiB: unexpected error: %v", err)
+			}
+			if want := tt.str(largeInput); got != want {
+				t.Errorf("8 MiB reader = %q, string = %q", got, want)
+			}
+
+			got, err = tt.fn(strings.NewReader(nist448))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if want := tt.str(nist448); got != want {
+				t.Errorf("reader = %q, string = %q", got, want)
+			}
+
+			if _, err := tt.fn(failingReader{}); err == nil {
+				t.Error("expected error from failing reader")
+			}
+		})
+	}
+}
+
+func TestHashReaderWrapsError(t *testing.T) {
+	sentinel := errors.New("disk error")
+	_, err := SHA256HashReader(io.MultiReader(strings.NewReader("partial"), &errReader{sentinel}))
+	if !errors.Is(err, sentinel) {
+		t.Errorf("error = %v, want wrapped %v", err, sentinel)
+	}
+}
+
+type errReader struct{ err error }
+
+func (r *errReader) Read([]byte) (int, error) { return 0, r.err }
@@ -10,8 +10,83 @@
 //
 //	hash := cryptox.SHA256Hash("data")
 //
+// SHA1（舊系統整合，例如 Git object ID）與 SHA512：
+//
+//	hash := cryptox.SHA1Hash("data")
+//	hash := cryptox.SHA512Hash("data")
+//
+// 大型檔案以串流計算 checksum（MD5、SHA1、SHA256、SHA512 皆有 Reader 版本）：
+//
+//	f, _ := os.Open("backup.tar.gz")
+//	defer f.Close()
+//	sum, err := cryptox.SHA256HashReader(f)
+//
+// 依名稱選擇演算法（md5、sha1、sha256、sha512，例如來自設定檔或 .sha256 檔案）：
+//
+//	sum, err := cryptox.FileChecksum("app.tar.gz", cryptox.ChecksumSHA256)
+//	sum, err := cryptox.ReaderChecksum(resp.Body, "sha512")
+//	if errors.Is(err, cryptox.ErrUnknownChecksumAlgorithm) { ... }
+//
+// # CRC32
+//
+// 偵測儲存或傳輸中的意外損毀（非加密用途）：
+//
+//	sum := cryptox.CRC32HashIEEE(data)                              // gzip、zip
+//	sum := cryptox.CRC32HashCastagnoli(data)                        // CRC-32C（iSCSI、ext4）
+//	s := cryptox.CRC32HashString(data, cryptox.CRC32PolyCastagnoli) // 8 個十六進位字元
+//
+// # Base64
+//
+// 統一 base64 編碼方式（解碼 URL-safe 字串時有無 padding 皆可）：
+//
+//	s := cryptox.Base64Encode(data)         // 標準編碼，含 =
+//	s := cryptox.Base64URLEncodeNoPad(data) // JWT 格式，無 =
+//	b, err := cryptox.Base64URLDecode(s)
+//
+// # HMAC
+//
+// 計算與驗證 webhook 簽章（GitHub、Stripe 等），驗證以常數時間比較：
+//
+//	sig := cryptox.HMACSHA256(body, secret) // 小寫十六進位
+//	ok := cryptox.VerifyHMAC(body, secret, sig, cryptox.HMACAlgSHA256)
+//
+// # 常數時間比較
+//
+// 比較 API key、token 等秘密值時避免 timing attack（不會在第一個不同的 byte 提早返回）：
+//
+//	ok := cryptox.SecureEqualString(r.Header.Get("X-API-Key"), apiKey)
+//	ok := cryptox.SecureEqual(gotMAC, wantMAC)
+//
+// # 隨機 Token
+//
+// 以 crypto/rand 產生 session token 與 API key（length 為亂度的 byte 數，而非輸出字元數）：
+//
+//	token, err := cryptox.GenerateToken(32)    // URL-safe base64，43 個字元
+//	hexTok, err := cryptox.GenerateHexToken(16) // 32 個十六進位字元
+//
+// # 對稱加密
+//
+// 以 AES-256-GCM 加密設定值或資料庫欄位（key 必須為 32 bytes，隨機 nonce 置於密文前方）：
+//
+//	sealed, err := cryptox.AESGCMEncrypt([]byte(secret), key)
+//	plain, err := cryptox.AESGCMDecrypt(sealed, key)
+//	if errors.Is(err, cryptox.ErrAuthenticationFailed) { ... } // 遭竄改或金鑰錯誤
+//
+// # 密碼雜湊
+//
+// 以 bcrypt 儲存與驗證密碼（隨機 salt，cost 必須介於 4–31）：
+//
+//	hash, err := cryptox.BcryptHashDefault(password) // cost 12
+//	ok := cryptox.BcryptVerify(input, hash)
+//
+// 或使用 OWASP 建議的 Argon2id（輸出包含參數與 salt 的 PHC 字串）：
+//
+//	encoded, err := cryptox.Argon2IDHash(password, cryptox.DefaultArgon2Params)
+//	// "$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>"
+//	ok, err := cryptox.Argon2IDVerify(input, encoded)
+//
 // # 安全提醒
 //
-// MD5 不應用於密碼儲存或安全敏感場景，建議使用 bcrypt 或 argon2。
-// SHA256 適用於資料完整性驗證，但密碼儲存仍建議使用專用演算法。
+// MD5 與 SHA1 已有實際的碰撞攻擊，不應用於密碼儲存、簽章或安全敏感場景，密碼請使用 Argon2IDHash 或 BcryptHash。
+// SHA256 與 SHA512 適用於資料完整性驗證，但密碼儲存仍建議使用專用演算法。
 package cryptox
@@ -0,0 +1,65 @@
+package cryptox
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"hash"
+	"strings"
+)
+
+// VerifyHMAC 支援的演算法名稱（不區分大小寫）。
+const (
+	HMACAlgSHA256 = "sha256"
+	HMACAlgSHA512 = "sha512"
+)
+
+// HMACSHA256 回傳 data 以 key 計算的 HMAC-SHA256（小寫十六進位字串），用於 webhook 簽章。
+func HMACSHA256(data, key []byte) string {
+	return hmacHex(sha256.New, data, key)
+}
+
+// HMACSHA512 回傳 data 以 key 計算的 HMAC-SHA512（小寫十六進位字串）。
+func HMACSHA512(data, key []byte) string {
+	return hmacHex(sha512.New, data, key)
+}
+
+// VerifyHMAC 驗證 signature 是否為 data 以 key 計算的 HMAC（十六進位，不區分大小寫），
+// alg 為 HMACAlgSHA256 或 HMACAlgSHA512。以 subtle.ConstantTimeCompare 比較，避免 timing attack；
+// alg 不支援或 signature 不是合法的十六進位時回傳 false。
+//
+// 帶有前綴的簽章（例如 GitHub 的 "sha256=..."）需先移除前綴：
+//
+//	sig := strings.TrimPrefix(r.Header.Get("X-Hub-Signature-256"), "sha256=")
+//	ok := cryptox.VerifyHMAC(body, secret, sig, cryptox.HMACAlgSHA256)
+func VerifyHMAC(data, key []byte, signature string, alg string) bool {
+	var newHash func() hash.Hash
+	switch strings.ToLower(alg) {
+	case HMACAlgSHA256:
+		newHash = sha256.New
+	case HMACAlgSHA512:
+		newHash = sha512.New
+	default:
+		return false
+	}
+
+	want, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(hmacSum(newHash, data, key), want) == 1
+}
+
+// hmacSum 以 newHash 計算 data 的 HMAC。
+func hmacSum(newHash func() hash.Hash, data, key []byte) []byte {
+	m := hmac.New(newHash, key)
+	m.Write(data)
+	return m.Sum(nil)
+}
+
+// hmacHex 同 hmacSum，但回傳小寫十六進位字串。
+func hmacHex(newHash func() hash.Hash, data, key []byte) string {
+	return hex.EncodeToString(hmacSum(newHash, data, key))
+}
@@ -0,0 +1,116 @@
+package cryptox
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// RFC 4231 測試向量
+var rfc4231Vectors = []struct {
+	name   string
+	key    []byte
+	data   []byte
+	sha256 string
+	sha512 string
+}{
+	{
+		name:   "case_1",
+		key:    bytes.Repeat([]byte{0x0b}, 20),
+		data:   []byte("Hi There"),
+		sha256: "b0344c61d8db38535ca8afceaf0bf12b881dc200c9833da726e9376c2e32cff7",
+		sha512: "87aa7cdea5ef619d4ff0b4241a1d6cb02379f4e2ce4ec2787ad0b30545e17cde" +
+			"daa833b7d6b8a702038b274eaea3f4e4be9d914eeb61f1702e696c203a126854",
+	},
+	{
+		name:   "case_2_short_key",
+		key:    []byte("Jefe"),
+		data:   []byte("what do ya want for nothing?"),
+		sha256: "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		sha512: "164b7a7bfcf819e2e395fbe73b56e0a387bd64222e831fd610270cd7ea250554" +
+			"9758bf75c05a994a6d034f65f8f0e6fdcaeab1a34d4a6b4b636e070a38bce737",
+	},
+	{
+		name:   "case_3",
+		key:    bytes.Repeat([]byte{0xaa}, 20),
+		data:   bytes.Repeat([]byte{0xdd}, 50),
+		sha256: "773ea91e36800e46854db8ebd09181a72959098b3ef8c122d9635514ced565fe",
+		sha512: "fa73b0089d56a284efb0f0756c890be9b1b5dbdd8ee81a3655f83e33b2279d39" +
+			"bf3e848279a722c806b485a47e67c807b946a337bee8942674278859e13292fb",
+	},
+	{
+		name:   "case_6_key_larger_than_block",
+		key:    bytes.Repeat([]byte{0xaa}, 131),
+		data:   []byte("Test Using Larger Than Block-Size Key - Hash Key First"),
+		sha256: "60e431591ee0b67f0d8a26aacbf5b77f8e0bc6213728c5140546040f0ee37f54",
+		sha512: "80b24263c7c1a3ebb71493c1dd7be8b49b46d1f41b4aeec1121b013783f8f352" +
+			"6b56d037e05f2598bd0fd2215d6a1e5295e64f73f63f0aec8b915a985d786598",
+	},
+}
+
+func TestHMACSHA256(t *testing.T) {
+	for _, tt := range rfc4231Vectors {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HMACSHA256(tt.data, tt.key); got != tt.sha256 {
+				t.Errorf("HMACSHA256() = %s, want %s", got, tt.sha256)
+			}
+		})
+	}
+}
+
+func TestHMACSHA512(t *testing.T) {
+	for _, tt := range rfc4231Vectors {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HMACSHA512(tt.data, tt.key); got != tt.sha512 {
+				t.Errorf("HMACSHA512() = %s, want %s", got, tt.sha512)
+			}
+		})
+	}
+}
+
+func TestVerifyHMAC(t *testing.T) {
+	for _, tt := range rfc4231Vectors {
+		t.Run(tt.name, func(t *testing.T) {
+			if !VerifyHMAC(tt.data, tt.key, tt.sha256, HMACAlgSHA256) {
+				t.Error("VerifyHMAC(sha256) = false, want true")
+			}
+			if !VerifyHMAC(tt.data, tt.key, tt.sha512, HMACAlgSHA512) {
+				t.Error("VerifyHMAC(sha512) = false, want true")
+			}
+			if !VerifyHMAC(tt.data, tt.key, strings.ToUpper(tt.sha256), "SHA256") {
+				t.Error("VerifyHMAC(uppercase) = false, want true")
+			}
+		})
+	}
+}
+
+func TestVerifyHMAC_Rejects(t *testing.T) {
+	v := rfc4231Vectors[0]
+
+	// 翻轉簽章最後一個 byte 的最低位元：f7 → f6
+	flipped := v.sha256[:len(v.sha256)-1] + "6"
+
+	tests := []struct {
+		name      string
+		data      []byte
+		signature string
+		alg       string
+	}{
+		{"flipped_bit", v.data, flipped, HMACAlgSHA256},
+		{"wrong_alg_for_signature", v.data, v.sha256, HMACAlgSHA512},
+		{"unknown_alg", v.data, v.sha256, "md5"},
+		{"empty_alg", v.data, v.sha256, ""},
+		{"tampered_data", []byte("Hi there"), v.sha256, HMACAlgSHA256},
+		{"truncated_signature", v.data, v.sha256[:32], HMACAlgSHA256},
+		{"not_hex", v.data, "sha256=" + v.sha256, HMACAlgSHA256},
+		{"empty_signature", v.data, "", HMACAlgSHA256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if VerifyHMAC(tt.data, v.key, tt.signature, tt.alg) {
+				t.Error("VerifyHMAC() = true, want false")
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package cryptox
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrInvalidTokenLength 表示 token 的長度不是正數。
+var ErrInvalidTokenLength = errors.New("cryptox: token length must be positive")
+
+// randReader 為產生 token、nonce 與 salt 使用的亂數來源，測試時可替換以模擬 crypto/rand 失敗。
+var randReader io.Reader = rand.Reader
+
+// GenerateToken 以 crypto/rand 產生 length bytes 的亂數，並以 URL-safe base64（無 padding）編碼，
+// 適用於 session token 與 API key。
+//
+// 注意 length 為亂度的 byte 數而非輸出字元數：輸出長度為 ceil(length*4/3)，
+// 例如 32 bytes（256 bits）產生 43 個字元。length <= 0 時回傳 ErrInvalidTokenLength。
+//
+//	token, err := cryptox.GenerateToken(32)
+func GenerateToken(length int) (string, error) {
+	b, err := randomBytes(length)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// GenerateHexToken 同 GenerateToken，但以小寫十六進位編碼，輸出長度為 2*length 個字元。
+func GenerateHexToken(length int) (string, error) {
+	b, err := randomBytes(length)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// randomBytes 從 randReader 讀取 n bytes 的亂數。
+func randomBytes(n int) ([]byte, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidTokenLength, n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(randReader, b); err != nil {
+		return nil, fmt.Errorf("cryptox: read random bytes: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,104 @@
+package cryptox
+
+import (
+	"encoding/base64"
+	"errors"
+	"regexp"
+	"testing"
+)
+
+var (
+	urlSafeRE = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	hexRE     = regexp.MustCompile(`^[0-9a-f]+$`)
+)
+
+// failingReader 模擬 crypto/rand 失敗（例如受限的 sandbox 環境）。
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("entropy unavailable") }
+
+func TestGenerateToken(t *testing.T) {
+	tests := []struct {
+		length  int
+		wantLen int
+	}{
+		{1, 2},
+		{16, 22},
+		{32, 43},
+		{64, 86},
+	}
+
+	for _, tt := range tests {
+		tok, err := GenerateToken(tt.length)
+		if err != nil {
+			t.Fatalf("GenerateToken(%d) error: %v", tt.length, err)
+		}
+		if len(tok) != tt.wantLen {
+			t.Errorf("len(GenerateToken(%d)) = %d, want %d", tt.length, len(tok), tt.wantLen)
+		}
+		if !urlSafeRE.MatchString(tok) {
+			t.Errorf("GenerateToken(%d) = %q, contains non URL-safe characters", tt.length, tok)
+		}
+		if b, err := base64.RawURLEncoding.DecodeString(tok); err != nil || len(b) != tt.length {
+			t.Errorf("decoded GenerateToken(%d) = %d bytes, %v, want %d bytes", tt.length, len(b), err, tt.length)
+		}
+	}
+}
+
+func TestGenerateHexToken(t *testing.T) {
+	for _, length := range []int{1, 16, 32} {
+		tok, err := GenerateHexToken(length)
+		if err != nil {
+			t.Fatalf("GenerateHexToken(%d) error: %v", length, err)
+		}
+		if len(tok) != 2*length {
+			t.Errorf("len(GenerateHexToken(%d)) = %d, want %d", length, len(tok), 2*length)
+		}
+		if !hexRE.MatchString(tok) {
+			t.Errorf("GenerateHexToken(%d) = %q, want lowercase hex", length, tok)
+		}
+	}
+}
+
+func TestGenerateToken_Unique(t *testing.T) {
+	a, _ := GenerateToken(32)
+	b, _ := GenerateToken(32)
+	if a == b {
+		t.Error("two consecutive GenerateToken() calls returned the same value")
+	}
+	c, _ := GenerateHexToken(32)
+	d, _ := GenerateHexToken(32)
+	if c == d {
+		t.Error("two consecutive GenerateHexToken() calls returned the same value")
+	}
+}
+
+func TestGenerateToken_InvalidLength(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if _, err := GenerateToken(n); !errors.Is(err, ErrInvalidTokenLength) {
+			t.Errorf("GenerateToken(%d) error = %v, want ErrInvalidTokenLength", n, err)
+		}
+		if _, err := GenerateHexToken(n); !errors.Is(err, ErrInvalidTokenLength) {
+			t.Errorf("GenerateHexToken(%d) error = %v, want ErrInvalidTokenLength", n, err)
+		}
+	}
+}
+
+func TestRandFailure(t *testing.T) {
+	orig := randReader
+	randReader = failingReader{}
+	t.Cleanup(func() { randReader = orig })
+
+	if _, err := GenerateToken(32); err == nil {
+		t.Error("GenerateToken() error = nil, want error")
+	}
+	if _, err := GenerateHexToken(32); err == nil {
+		t.Error("GenerateHexToken() error = nil, want error")
+	}
+	if _, err := AESGCMEncrypt([]byte("x"), testAESKey()); err == nil {
+		t.Error("AESGCMEncrypt() error = nil, want error")
+	}
+	if _, err := Argon2IDHash("pw", Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 16}); err == nil {
+		t.Error("Argon2IDHash() error = nil, want error")
+	}
+}
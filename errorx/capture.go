@@ -0,0 +1,26 @@
+package errorx
+
+// Ignore 明確忽略錯誤，用於表達「此錯誤可安全忽略」並滿足 linter（例如 errcheck）。
+// 本函式不做任何事。在 defer 中使用時必須包在函式內，否則 defer 會立即求值參數，
+// 在 defer 敘述執行的當下就關閉 Body：
+//
+//	defer func() { errorx.Ignore(resp.Body.Close()) }()
+func Ignore(err error) {}
+
+// Capture 執行 fn，並在 *dst 仍為 nil 時將 fn 的錯誤寫入 *dst；*dst 已有錯誤時保留原錯誤。
+// 用於在 defer 中傳遞 Close 等清理動作的錯誤，須搭配具名回傳值：
+//
+//	func writeFile(name string) (err error) {
+//	    f, err := os.Create(name)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    defer errorx.Capture(&err, f.Close)
+//	    _, err = f.Write(data)
+//	    return err
+//	}
+func Capture(dst *error, fn func() error) {
+	if err := fn(); err != nil && *dst == nil {
+		*dst = err
+	}
+}
@@ -0,0 +1,29 @@
+package errorx
+
+import "errors"
+
+// CodedError 攜帶整數錯誤碼的錯誤（例如 HTTP 狀態碼或業務錯誤碼），
+// 可直接對應 httpx/resp.Error 的 Code 與 Message。
+type CodedError struct {
+	Code int
+	Msg  string
+}
+
+// Error 實作 error 介面，回傳錯誤訊息。
+func (e *CodedError) Error() string {
+	return e.Msg
+}
+
+// NewCoded 建立帶錯誤碼的錯誤。
+func NewCoded(code int, msg string) error {
+	return &CodedError{Code: code, Msg: msg}
+}
+
+// Code 沿錯誤鏈找出最近的 CodedError 並回傳其錯誤碼；找不到時回傳 0, false。
+func Code(err error) (int, bool) {
+	var ce *CodedError
+	if errors.As(err, &ce) {
+		return ce.Code, true
+	}
+	return 0, false
+}
@@ -13,6 +13,15 @@ func Wrap(err error, msg string) error {
 	return fmt.Errorf("%s: %w", msg, err)
 }
 
+// Wrapf 包裝錯誤並加上格式化訊息，例如 Wrapf(err, "read file %s", name)。
+// err 為 nil 時回傳 nil。
+func Wrapf(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err)
+}
+
 // Is 判斷錯誤鏈是否包含 target。
 func Is(err, target error) bool {
 	return errors.Is(err, target)
@@ -24,6 +33,8 @@ func As[T any](err error, target *T) bool {
 }
 
 // Cause 取出最底層錯誤。
+// 只沿著單一錯誤的 Unwrap() error 往下走；遇到 errors.Join 或多個 %w 產生的錯誤
+// （Unwrap() []error）即停止並回傳該錯誤本身。需要所有底層錯誤時請使用 Causes。
 func Cause(err error) error {
 	for {
 		unwrapped := errors.Unwrap(err)
@@ -33,3 +44,27 @@ func Cause(err error) error {
 		err = unwrapped
 	}
 }
+
+// Causes 展開錯誤樹並回傳所有最底層錯誤（依出現順序），
+// 同時支援 Unwrap() error 與 errors.Join 的 Unwrap() []error。err 為 nil 時回傳 nil。
+//
+//	err := errors.Join(Wrap(io.EOF, "read"), Wrap(os.ErrNotExist, "open"))
+//	errorx.Causes(err) // [io.EOF, os.ErrNotExist]
+func Causes(err error) []error {
+	if err == nil {
+		return nil
+	}
+	switch u := err.(type) {
+	case interface{ Unwrap() []error }:
+		var causes []error
+		for _, e := range u.Unwrap() {
+			causes = append(causes, Causes(e)...)
+		}
+		return causes
+	case interface{ Unwrap() error }:
+		if inner := u.Unwrap(); inner != nil {
+			return Causes(inner)
+		}
+	}
+	return []error{err}
+}
@@ -2,7 +2,9 @@ package errorx
 
 import (
 	"errors"
+	"fmt"
 	"io"
+	"strings"
 	"testing"
 )
 
@@ -26,3 +28,244 @@ func TestIsAndAs(t *testing.T) {
 		t.Fatal("expected As to succeed")
 	}
 }
+
+func TestWrapf(t *testing.T) {
+	if Wrapf(nil, "read file %s", "a.txt") != nil {
+		t.Fatal("expected nil for nil error")
+	}
+
+	err := Wrapf(io.EOF, "read file %s (line %d)", "a.txt", 3)
+	if got, want := err.Error(), "read file a.txt (line 3): EOF"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+	if !errors.Is(err, io.EOF) {
+		t.Fatal("expected errors.Is to find EOF")
+	}
+	if Cause(err) != io.EOF {
+		t.Fatalf("expected EOF cause, got %v", Cause(err))
+	}
+}
+
+func TestCodedError(t *testing.T) {
+	err := NewCoded(404, "user not found")
+	if err.Error() != "user not found" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+
+	wrapped := Wrap(Wrapf(err, "load user %d", 42), "handler")
+	code, ok := Code(wrapped)
+	if !ok || code != 404 {
+		t.Fatalf("expected code 404, got %d (ok=%v)", code, ok)
+	}
+
+	var ce *CodedError
+	if !As(wrapped, &ce) || ce.Msg != "user not found" {
+		t.Fatalf("expected As to find CodedError, got %v", ce)
+	}
+}
+
+func TestCode_Nearest(t *testing.T) {
+	inner := NewCoded(500, "db down")
+	outer := fmt.Errorf("%w: %w", NewCoded(503, "unavailable"), inner)
+	if code, _ := Code(Wrap(outer, "ctx")); code != 503 {
+		t.Fatalf("expected nearest code 503, got %d", code)
+	}
+}
+
+func TestCode_NotFound(t *testing.T) {
+	if code, ok := Code(errors.New("plain")); ok || code != 0 {
+		t.Fatalf("expected (0, false), got (%d, %v)", code, ok)
+	}
+	if _, ok := Code(nil); ok {
+		t.Fatal("expected false for nil error")
+	}
+}
+
+func TestMultiError(t *testing.T) {
+	var m MultiError
+	if m.ErrorOrNil() != nil || m.Len() != 0 {
+		t.Fatal("expected nil for empty MultiError")
+	}
+
+	m.Add(nil)
+	if m.ErrorOrNil() != nil || m.Len() != 0 {
+		t.Fatal("expected nil additions to be ignored")
+	}
+
+	m.Add(errors.New("name is required"))
+	m.Add(nil)
+	m.Add(NewCoded(400, "age must be positive"))
+	if m.Len() != 2 {
+		t.Fatalf("expected 2 errors, got %d", m.Len())
+	}
+
+	err := m.ErrorOrNil()
+	if got, want := err.Error(), "name is required\nage must be positive"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+	if code, ok := Code(err); !ok || code != 400 {
+		t.Fatalf("expected code 400 through joined error, got %d (ok=%v)", code, ok)
+	}
+}
+
+func TestWithStack(t *testing.T) {
+	if WithStack(nil) != nil {
+		t.Fatal("expected nil for nil error")
+	}
+
+	err := Wrap(WithStack(io.EOF), "read")
+	if err.Error() != "read: EOF" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+	if !errors.Is(err, io.EOF) || Cause(err) != io.EOF {
+		t.Fatal("expected errors.Is and Unwrap to traverse the stack wrapper")
+	}
+
+	if len(StackTrace(err)) == 0 {
+		t.Fatal("expected a captured stack")
+	}
+	stack := FormatStack(err)
+	if !strings.Contains(stack, "errorx.TestWithStack") || !strings.Contains(stack, "errorx_test.go:") {
+		t.Fatalf("expected stack to contain the call site, got:\n%s", stack)
+	}
+	if strings.Contains(stack, "errorx.WithStack") {
+		t.Fatalf("expected WithStack itself to be skipped, got:\n%s", stack)
+	}
+}
+
+func TestWithStack_KeepsInnermost(t *testing.T) {
+	inner := WithStack(io.EOF)
+	outer := WithStack(Wrap(inner, "outer"))
+	if outer.Error() != "outer: EOF" {
+		t.Fatalf("unexpected message %q", outer.Error())
+	}
+	if &StackTrace(outer)[0] != &StackTrace(inner)[0] {
+		t.Fatal("expected the innermost stack to be kept")
+	}
+}
+
+func TestStackTrace_None(t *testing.T) {
+	if StackTrace(io.EOF) != nil || FormatStack(io.EOF) != "" {
+		t.Fatal("expected no stack for plain error")
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	timeout := errors.New("i/o timeout")
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"unmarked", timeout, false},
+		{"retryable", MarkRetryable(timeout), true},
+		{"retryable_wrapped", Wrap(Wrapf(MarkRetryable(timeout), "call %s", "svc"), "handler"), true},
+		{"permanent", MarkPermanent(timeout), false},
+		{"permanent_wrapped", Wrap(MarkPermanent(timeout), "handler"), false},
+		// 最近的標記優先
+		{"permanent_overrides", MarkPermanent(Wrap(MarkRetryable(timeout), "ctx")), false},
+		{"retryable_overrides", MarkRetryable(MarkPermanent(timeout)), true},
+		{"with_stack", WithStack(MarkRetryable(timeout)), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkRetryable_Transparent(t *testing.T) {
+	if MarkRetryable(nil) != nil || MarkPermanent(nil) != nil {
+		t.Fatal("expected nil for nil error")
+	}
+
+	err := Wrap(MarkRetryable(io.EOF), "read")
+	if err.Error() != "read: EOF" || !errors.Is(err, io.EOF) {
+		t.Fatalf("expected marker to be transparent, got %q", err.Error())
+	}
+}
+
+func TestCauses(t *testing.T) {
+	if Causes(nil) != nil {
+		t.Fatal("expected nil for nil error")
+	}
+
+	root := errors.New("root")
+	if got := Causes(Wrap(Wrap(root, "a"), "b")); len(got) != 1 || got[0] != root {
+		t.Fatalf("expected [root], got %v", got)
+	}
+
+	joined := errors.Join(
+		Wrap(WithStack(io.EOF), "read"),
+		Wrapf(MarkRetryable(io.ErrUnexpectedEOF), "decode %s", "body"),
+	)
+	got := Causes(Wrap(joined, "shutdown"))
+	if len(got) != 2 || got[0] != io.EOF || got[1] != io.ErrUnexpectedEOF {
+		t.Fatalf("expected [EOF, unexpected EOF], got %v", got)
+	}
+
+	// Cause 遇到 Join 即停止
+	if Cause(joined) != joined {
+		t.Fatalf("expected Cause to stop at joined error, got %v", Cause(joined))
+	}
+}
+
+func TestCauses_NestedJoin(t *testing.T) {
+	a, b, c := errors.New("a"), errors.New("b"), errors.New("c")
+	err := errors.Join(a, fmt.Errorf("wrap: %w, %w", b, errors.Join(c, nil)))
+	got := Causes(err)
+	if len(got) != 3 || got[0] != a || got[1] != b || got[2] != c {
+		t.Fatalf("expected [a b c], got %v", got)
+	}
+}
+
+func TestIgnore(t *testing.T) {
+	Ignore(nil)
+	Ignore(io.EOF) // 不應 panic 或有任何副作用
+}
+
+func TestCapture(t *testing.T) {
+	closeErr := errors.New("close failed")
+	mainErr := errors.New("write failed")
+
+	run := func(mainResult, closeResult error) (err error) {
+		defer Capture(&err, func() error { return closeResult })
+		return mainResult
+	}
+
+	tests := []struct {
+		name        string
+		main, close error
+		want        error
+	}{
+		{"both_ok", nil, nil, nil},
+		{"close_failed", nil, closeErr, closeErr},
+		{"main_failed", mainErr, nil, mainErr},
+		{"keep_earlier_error", mainErr, closeErr, mainErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.main, tt.close); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCapture_CallsFn(t *testing.T) {
+	called := false
+	err := io.EOF
+	Capture(&err, func() error {
+		called = true
+		return nil
+	})
+	if !called || err != io.EOF {
+		t.Fatalf("expected fn to be called and error preserved, got called=%v err=%v", called, err)
+	}
+}
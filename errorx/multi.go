@@ -0,0 +1,30 @@
+package errorx
+
+import "errors"
+
+// MultiError 逐步收集多個錯誤（例如驗證多個欄位時一次回報所有失敗）。
+// 零值即可使用；非併發安全。
+type MultiError struct {
+	errs []error
+}
+
+// Add 加入錯誤，nil 會被忽略。
+func (m *MultiError) Add(err error) {
+	if err != nil {
+		m.errs = append(m.errs, err)
+	}
+}
+
+// Len 回傳已收集的錯誤數量。
+func (m *MultiError) Len() int {
+	return len(m.errs)
+}
+
+// ErrorOrNil 回傳以 errors.Join 合併的錯誤；沒有任何錯誤時回傳 nil。
+// 合併後的錯誤支援 errors.Is / errors.As 找出其中任一錯誤。
+func (m *MultiError) ErrorOrNil() error {
+	if len(m.errs) == 0 {
+		return nil
+	}
+	return errors.Join(m.errs...)
+}
@@ -0,0 +1,44 @@
+package errorx
+
+import "errors"
+
+// retryableError 標記錯誤是否可重試。
+type retryableError struct {
+	err       error
+	retryable bool
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+
+func (e *retryableError) Unwrap() error { return e.err }
+
+// MarkRetryable 將錯誤標記為暫時性（可重試），例如網路逾時、429、503。
+// 錯誤訊息不變，errors.Is / errors.As 仍可穿透。err 為 nil 時回傳 nil。
+func MarkRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err, retryable: true}
+}
+
+// MarkPermanent 將錯誤標記為永久性（不可重試），例如參數錯誤、權限不足。
+// 可用於覆蓋錯誤鏈內層的 MarkRetryable。err 為 nil 時回傳 nil。
+func MarkPermanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err, retryable: false}
+}
+
+// IsRetryable 沿錯誤鏈找出最近的標記並回傳是否可重試；沒有任何標記時回傳 false。
+//
+//	if errorx.IsRetryable(err) {
+//	    continue // 重試
+//	}
+func IsRetryable(err error) bool {
+	var re *retryableError
+	if errors.As(err, &re) {
+		return re.retryable
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package errorx
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// maxStackDepth 為 WithStack 記錄的最大呼叫層數。
+const maxStackDepth = 32
+
+// stackError 包裝錯誤並記錄建立時的呼叫堆疊。
+type stackError struct {
+	err error
+	pcs []uintptr
+}
+
+func (e *stackError) Error() string { return e.err.Error() }
+
+func (e *stackError) Unwrap() error { return e.err }
+
+// WithStack 在呼叫處記錄堆疊並包裝錯誤，錯誤訊息不變，errors.Is / errors.As / Unwrap 仍可穿透。
+// err 為 nil 時回傳 nil；錯誤鏈中已有堆疊時直接回傳 err，保留最接近錯誤來源的堆疊。
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+	var se *stackError
+	if errors.As(err, &se) {
+		return err
+	}
+
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(2, pcs) // 略過 runtime.Callers 與 WithStack
+	return &stackError{err: err, pcs: pcs[:n]}
+}
+
+// StackTrace 回傳錯誤鏈中由 WithStack 記錄的堆疊（program counters），沒有時回傳 nil。
+func StackTrace(err error) []uintptr {
+	var se *stackError
+	if errors.As(err, &se) {
+		return se.pcs
+	}
+	return nil
+}
+
+// FormatStack 將錯誤鏈中的堆疊格式化為多行字串（每層為函式名稱與檔案:行號），沒有時回傳空字串。
+//
+//	main.loadUser
+//		/app/main.go:42
+//	main.main
+//		/app/main.go:17
+func FormatStack(err error) string {
+	pcs := StackTrace(err)
+	if len(pcs) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
@@ -4,12 +4,21 @@ package graceful
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"os/signal"
-	"syscall"
+	"runtime/debug"
 	"time"
 )
 
+// ErrTaskTimeout is returned by Run when the task does not return within the
+// grace period after a shutdown signal is received.
+var ErrTaskTimeout = errors.New("graceful: task did not exit in time")
+
+// ErrTaskPanic is returned (wrapped together with the panic value) by Run when the
+// task panics and recovery is enabled (see WithRecover).
+var ErrTaskPanic = errors.New("graceful: task panicked")
+
 // Task represents a long-running task that should listen for context cancellation.
 // It should return when the context is done or when a fatal error occurs.
 type Task func(ctx context.Context) error
@@ -20,7 +29,13 @@ type Task func(ctx context.Context) error
 type Cleaner func(ctx context.Context) error
 
 // Run executes the given task and handles graceful shutdown on system signals.
-// It listens for SIGINT and SIGTERM.
+// It listens for SIGINT and SIGTERM by default; use WithSignals to customize.
+// Cancelling the parent context set via WithContext triggers shutdown the same way.
+// The task runs in its own goroutine. After a signal is received, Run waits up to
+// the grace period (see WithGracePeriod) for the task to return, then proceeds to
+// cleanup anyway and reports ErrTaskTimeout.
+// A nil task simply waits for the shutdown signal, which is useful when all the
+// work is registered through options such as ServeHTTP.
 func Run(task Task, opts ...Option) error {
 	o := defaultOptions()
 	for _, opt := range opts {
@@ -32,38 +47,104 @@ func Run(task Task, opts ...Option) error {
 	// (its Done channel is closed) when one of the listed signals arrives,
 	// when the returned stop function is called, or when the parent context's
 	// Done channel is closed, whichever happens first.
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	// The parent defaults to context.Background() and can be set via WithContext.
+	ctx, stop := signal.NotifyContext(o.parent, o.signals...)
 	defer stop()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if task == nil {
+		task = func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		}
+	}
+
+	// Start services registered via options (e.g. ServeHTTP).
+	// A failing service triggers shutdown just like a signal does.
+	serviceErrCh := make(chan error, len(o.services))
+	for _, svc := range o.services {
+		go func() {
+			if err := svc(ctx); err != nil {
+				o.logger.Error("service failed", "error", err)
+				serviceErrCh <- err
+				cancel()
+			}
+		}()
+	}
 
 	// 2. Run the task
 	o.logger.Info("starting task")
 	startTime := time.Now()
 
-	// Execute the task. Expected to block until done or ctx is done.
-	err := task(ctx)
+	// Execute the task in its own goroutine, so a task that ignores ctx
+	// cannot block shutdown forever.
+	taskErrCh := make(chan error, 1)
+	go func() {
+		if o.recover {
+			// Convert a panic into an error so that cleanup still runs.
+			defer func() {
+				if r := recover(); r != nil {
+					o.logger.Error("task panicked", "panic", r, "stack", string(debug.Stack()))
+					taskErrCh <- panicError(r)
+				}
+			}()
+		}
+		taskErrCh <- task(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-taskErrCh:
+	case <-ctx.Done():
+		// Signal received (or parent cancelled). Give the task a grace period to return on its own.
+		o.logger.Info("shutdown signal received, waiting for task to exit", "grace_period", o.gracePeriod)
+		timer := time.NewTimer(o.gracePeriod)
+		select {
+		case err = <-taskErrCh:
+		case <-timer.C:
+			// Proceed to cleanup anyway; the task goroutine is abandoned.
+			err = fmt.Errorf("%w (grace period %v)", ErrTaskTimeout, o.gracePeriod)
+		}
+		timer.Stop()
+	}
+
+	// Collect errors from services that have already failed.
+	for len(serviceErrCh) > 0 {
+		err = errors.Join(err, <-serviceErrCh)
+	}
 
 	// Log task exit
 	duration := time.Since(startTime)
-	if err != nil {
+	switch {
+	case errors.Is(err, ErrTaskTimeout):
+		o.logger.Error("task did not exit in time", "grace_period", o.gracePeriod, "duration", duration)
+	case err != nil:
 		o.logger.Error("task exited with error", "error", err, "duration", duration)
-	} else {
+	default:
 		o.logger.Info("task exited successfully", "duration", duration)
 	}
 
 	// 3. Run cleanup
 	// We create a new context for cleanup since the signal context is already done.
+	// It keeps the parent's values but not its cancellation.
 	o.logger.Info("starting shutdown cleanup", "timeout", o.shutdownTimeout)
 
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), o.shutdownTimeout)
-	defer cancel()
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.WithoutCancel(o.parent), o.shutdownTimeout)
+	defer shutdownCancel()
 
 	var cleanupErrors []error
 	// Execute cleaners in LIFO order (Last-In-First-Out)
 	// Ensures resources with higher dependencies (usually registered later) are released first
 	for i := len(o.cleaners) - 1; i >= 0; i-- {
 		c := o.cleaners[i]
-		if cErr := c(shutdownCtx); cErr != nil {
-			o.logger.Error("cleanup failed", "error", cErr)
+		if cErr := c.fn(shutdownCtx); cErr != nil {
+			if c.name != "" {
+				o.logger.Error("cleanup failed", "name", c.name, "error", cErr)
+				cErr = fmt.Errorf("cleanup %q: %w", c.name, cErr)
+			} else {
+				o.logger.Error("cleanup failed", "error", cErr)
+			}
 			cleanupErrors = append(cleanupErrors, cErr)
 		}
 	}
@@ -80,14 +161,22 @@ func Run(task Task, opts ...Option) error {
 	return err
 }
 
+// panicError converts a recovered panic value into an error wrapping ErrTaskPanic.
+// If the panic value is itself an error, it is kept in the chain for errors.Is/As.
+func panicError(r any) error {
+	if err, ok := r.(error); ok {
+		return fmt.Errorf("%w: %w", ErrTaskPanic, err)
+	}
+	return fmt.Errorf("%w: %v", ErrTaskPanic, r)
+}
+
 // HTTPTask wraps an http.Server as a graceful.Task.
 // It starts the server in a goroutine and waits for context cancellation.
-// Note: You typically need to register a cleanup function to shutdown the server, e.g.:
+// Note: this helper only handles the "run" part. The server keeps running after
+// the task returns, so you must also register srv.Shutdown as a cleanup, e.g.:
 // graceful.WithCleanup(func(ctx context.Context) error { return srv.Shutdown(ctx) })
-// or use a helper function that handles this logic if preferred.
 //
-// However, to keep it simple and composable, this helper only handles the "run" part.
-// The user is responsible for ensuring srv.Shutdown is called during cleanup.
+// Prefer ServeHTTP, which registers both the run goroutine and the shutdown cleaner.
 func HTTPTask(srv *http.Server) Task {
 	return func(ctx context.Context) error {
 		errCh := make(chan error, 1)
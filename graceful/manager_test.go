@@ -4,6 +4,14 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -189,3 +197,431 @@ func TestRun_CleanupOrder(t *testing.T) {
 		t.Errorf("Cleanup execution order error. expected [2, 1], got %v", executionOrder)
 	}
 }
+
+func TestWithSignals_CustomSignalTriggersShutdown(t *testing.T) {
+	// 任務向自身送出 SIGHUP，並等待 ctx 因訊號而結束
+	task := func(ctx context.Context) error {
+		p, err := os.FindProcess(os.Getpid())
+		if err != nil {
+			return err
+		}
+		if err := p.Signal(syscall.SIGHUP); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(2 * time.Second):
+			return errors.New("custom signal did not cancel the task context")
+		}
+	}
+
+	cleanupCalled := false
+	cleanup := func(_ context.Context) error {
+		cleanupCalled = true
+		return nil
+	}
+
+	if err := Run(task, WithSignals(syscall.SIGHUP), WithCleanup(cleanup)); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !cleanupCalled {
+		t.Error("cleanup should be called after custom signal")
+	}
+}
+
+func TestWithSignals_EmptyKeepsDefault(t *testing.T) {
+	o := defaultOptions()
+	WithSignals()(o)
+
+	if len(o.signals) != 2 || o.signals[0] != syscall.SIGINT || o.signals[1] != syscall.SIGTERM {
+		t.Errorf("signals = %v, want [SIGINT SIGTERM]", o.signals)
+	}
+}
+
+func TestRun_TaskIgnoresCancellation(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	// 任務送出訊號後忽略 ctx，持續阻塞
+	task := func(_ context.Context) error {
+		p, err := os.FindProcess(os.Getpid())
+		if err != nil {
+			return err
+		}
+		if err := p.Signal(syscall.SIGHUP); err != nil {
+			return err
+		}
+		<-release
+		return nil
+	}
+
+	cleanupCalled := false
+	cleanup := func(_ context.Context) error {
+		cleanupCalled = true
+		return nil
+	}
+
+	start := time.Now()
+	err := Run(task,
+		WithSignals(syscall.SIGHUP),
+		WithGracePeriod(50*time.Millisecond),
+		WithCleanup(cleanup),
+	)
+	duration := time.Since(start)
+
+	if !errors.Is(err, ErrTaskTimeout) {
+		t.Fatalf("預期 ErrTaskTimeout，但得到 %v", err)
+	}
+	if duration > time.Second {
+		t.Errorf("Run 應在寬限期後返回，但花費了 %v", duration)
+	}
+	if !cleanupCalled {
+		t.Error("任務未在寬限期內退出時，仍應執行清理函式")
+	}
+}
+
+func TestWithNamedCleanup_ErrorContainsName(t *testing.T) {
+	task := func(_ context.Context) error { return nil }
+	boom := errors.New("connection reset")
+
+	err := Run(task,
+		WithNamedCleanup("postgres", func(_ context.Context) error { return boom }),
+		WithNamedCleanup("redis", func(_ context.Context) error { return nil }),
+	)
+	if err == nil {
+		t.Fatal("預期清理失敗的錯誤")
+	}
+	if !strings.Contains(err.Error(), "postgres") {
+		t.Errorf("錯誤訊息應包含清理名稱，但得到 %q", err.Error())
+	}
+	if strings.Contains(err.Error(), "redis") {
+		t.Errorf("成功的清理不應出現在錯誤中，但得到 %q", err.Error())
+	}
+	if !errors.Is(err, boom) {
+		t.Errorf("錯誤應保留原始錯誤鏈，但得到 %v", err)
+	}
+}
+
+func TestWithNamedCloser(t *testing.T) {
+	m := &mockCloser{}
+	task := func(_ context.Context) error { return nil }
+
+	if err := Run(task, WithNamedCloser("db", m)); err != nil {
+		t.Errorf("Run() error = %v", err)
+	}
+	if !m.closed {
+		t.Error("named closer should be closed")
+	}
+}
+
+func TestWithParallelCleanup_Concurrent(t *testing.T) {
+	task := func(_ context.Context) error { return nil }
+
+	sleeper := func(d time.Duration) Cleaner {
+		return func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(d):
+				return nil
+			}
+		}
+	}
+
+	start := time.Now()
+	err := Run(task, WithParallelCleanup(
+		sleeper(100*time.Millisecond),
+		sleeper(100*time.Millisecond),
+		sleeper(100*time.Millisecond),
+	))
+	duration := time.Since(start)
+
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	// 並行執行應接近最長者（100ms），而非總和（300ms）
+	if duration >= 250*time.Millisecond {
+		t.Errorf("並行清理應約為最長清理時間，但花費了 %v", duration)
+	}
+}
+
+func TestWithParallelCleanup_JoinErrorsAndOrder(t *testing.T) {
+	task := func(_ context.Context) error { return nil }
+
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+
+	var order []string
+	var mu sync.Mutex
+	record := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, name)
+	}
+
+	err := Run(task,
+		WithCleanup(func(_ context.Context) error { record("first"); return nil }),
+		WithParallelCleanup(
+			func(_ context.Context) error { record("group"); return errA },
+			func(_ context.Context) error { record("group"); return errB },
+			nil,
+		),
+		WithCleanup(func(_ context.Context) error { record("last"); return nil }),
+	)
+
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Errorf("預期合併所有並行清理錯誤，但得到 %v", err)
+	}
+
+	// 群組整體仍遵循 LIFO：last → group → first
+	want := []string{"last", "group", "group", "first"}
+	if len(order) != len(want) {
+		t.Fatalf("execution order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("execution order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestWithParallelCleanup_TimeoutKeepsFinishedErrors(t *testing.T) {
+	task := func(_ context.Context) error { return nil }
+
+	errFast := errors.New("fast failed")
+	release := make(chan struct{})
+	defer close(release)
+
+	err := Run(task,
+		WithTimeout(100*time.Millisecond),
+		WithParallelCleanup(
+			func(_ context.Context) error { return errFast },
+			func(_ context.Context) error { <-release; return nil }, // 忽略 ctx，超過逾時
+		),
+	)
+
+	if !errors.Is(err, errFast) {
+		t.Errorf("預期保留已完成清理的錯誤，但得到 %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("預期包含逾時錯誤，但得到 %v", err)
+	}
+}
+
+func TestWithRecover_TaskPanic(t *testing.T) {
+	task := func(_ context.Context) error {
+		panic("something went wrong")
+	}
+
+	cleanupCalled := false
+	cleanup := func(_ context.Context) error {
+		cleanupCalled = true
+		return nil
+	}
+
+	err := Run(task, WithCleanup(cleanup))
+	if !errors.Is(err, ErrTaskPanic) {
+		t.Fatalf("預期 ErrTaskPanic，但得到 %v", err)
+	}
+	if !strings.Contains(err.Error(), "something went wrong") {
+		t.Errorf("錯誤訊息應包含 panic 值，但得到 %q", err.Error())
+	}
+	if !cleanupCalled {
+		t.Error("任務 panic 時仍應執行清理函式")
+	}
+}
+
+func TestWithRecover_PanicWithError(t *testing.T) {
+	root := errors.New("root cause")
+	task := func(_ context.Context) error {
+		panic(root)
+	}
+
+	err := Run(task)
+	if !errors.Is(err, ErrTaskPanic) || !errors.Is(err, root) {
+		t.Errorf("錯誤應同時包含 ErrTaskPanic 與原始錯誤，但得到 %v", err)
+	}
+}
+
+// freeAddr 取得一個可用的本機位址
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	return addr
+}
+
+func TestServeHTTP_ShutdownStopsServer(t *testing.T) {
+	addr := freeAddr(t)
+	srv := &http.Server{
+		Addr: addr,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	}
+	url := "http://" + addr
+
+	// 任務確認 server 可連線後，送出 SIGHUP 觸發關機
+	task := func(ctx context.Context) error {
+		var up bool
+		for i := 0; i < 100 && !up; i++ {
+			if res, err := http.Get(url); err == nil {
+				res.Body.Close()
+				up = res.StatusCode == http.StatusNoContent
+			} else {
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+		if !up {
+			return errors.New("server did not start")
+		}
+
+		p, err := os.FindProcess(os.Getpid())
+		if err != nil {
+			return err
+		}
+		if err := p.Signal(syscall.SIGHUP); err != nil {
+			return err
+		}
+		<-ctx.Done()
+		return nil
+	}
+
+	if err := Run(task, WithSignals(syscall.SIGHUP), ServeHTTP(srv), WithTimeout(time.Second)); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	client := &http.Client{Timeout: time.Second}
+	if res, err := client.Get(url); err == nil {
+		res.Body.Close()
+		t.Fatal("server should stop accepting connections after shutdown")
+	}
+
+	// 使用 httptest 確認 handler 本身正常，失敗僅來自 server 已關閉
+	rec := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("handler status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+}
+
+func TestServeHTTP_ListenErrorTriggersShutdown(t *testing.T) {
+	// 先佔用 port，使 ListenAndServe 失敗
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	defer l.Close()
+
+	srv := &http.Server{Addr: l.Addr().String()}
+	taskDone := false
+	task := func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			taskDone = true
+			return nil
+		case <-time.After(2 * time.Second):
+			return errors.New("service failure did not cancel the task context")
+		}
+	}
+
+	err = Run(task, ServeHTTP(srv), WithTimeout(time.Second))
+	if err == nil || !strings.Contains(err.Error(), "address already in use") {
+		t.Fatalf("Run() error = %v, want address already in use", err)
+	}
+	if !taskDone {
+		t.Error("task should observe ctx cancellation")
+	}
+}
+
+func TestRun_NilTaskWaitsForSignal(t *testing.T) {
+	// 先自行註冊 SIGHUP，避免 Run 尚未開始監聽時訊號的預設動作終止整個測試程式
+	guard := make(chan os.Signal, 1)
+	signal.Notify(guard, syscall.SIGHUP)
+	defer signal.Stop(guard)
+
+	done := make(chan error, 1)
+	go func() { done <- Run(nil, WithSignals(syscall.SIGHUP)) }()
+
+	// 無法得知 Run 何時開始監聽，因此重複送出訊號直到 Run 結束
+	p, _ := os.FindProcess(os.Getpid())
+	tick := time.NewTicker(20 * time.Millisecond)
+	defer tick.Stop()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("Run(nil) error = %v", err)
+			}
+			return
+		case <-tick.C:
+			_ = p.Signal(syscall.SIGHUP)
+		case <-timeout:
+			t.Fatal("Run(nil) did not return after SIGHUP")
+		}
+	}
+}
+
+type ctxKey struct{}
+
+func TestWithContext_ParentCancelTriggersShutdown(t *testing.T) {
+	parent, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "app"))
+
+	taskStarted := make(chan struct{})
+	task := func(ctx context.Context) error {
+		close(taskStarted)
+		<-ctx.Done()
+		return nil
+	}
+
+	var cleanupErr error
+	var cleanupValue any
+	cleanup := func(ctx context.Context) error {
+		// 清理階段的 ctx 保留父 context 的值，但不因父 context 取消而結束
+		cleanupErr = ctx.Err()
+		cleanupValue = ctx.Value(ctxKey{})
+		return nil
+	}
+
+	go func() {
+		<-taskStarted
+		cancel()
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- Run(task, WithContext(parent), WithCleanup(cleanup))
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("cancelling the parent context should trigger shutdown")
+	}
+
+	if cleanupErr != nil {
+		t.Errorf("cleanup ctx.Err() = %v, want nil", cleanupErr)
+	}
+	if cleanupValue != "app" {
+		t.Errorf("cleanup ctx value = %v, want %q", cleanupValue, "app")
+	}
+}
+
+func TestWithContext_NilKeepsDefault(t *testing.T) {
+	o := defaultOptions()
+	//nolint:staticcheck // 測試 nil context 的處理
+	WithContext(nil)(o)
+
+	if o.parent == nil {
+		t.Error("WithContext(nil) should keep the default parent context")
+	}
+}
@@ -2,9 +2,13 @@ package graceful
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"net/http"
+	"os"
+	"syscall"
 	"time"
 )
 
@@ -12,17 +16,32 @@ import (
 type Option func(*options)
 
 type options struct {
+	parent          context.Context
 	shutdownTimeout time.Duration
+	gracePeriod     time.Duration
 	logger          *slog.Logger
-	cleaners        []Cleaner
+	cleaners        []namedCleaner
+	services        []Task
+	signals         []os.Signal
+	recover         bool
+}
+
+// namedCleaner pairs a Cleaner with an optional name used in logs and errors.
+type namedCleaner struct {
+	name string
+	fn   Cleaner
 }
 
 // defaultOptions returns the default options.
 func defaultOptions() *options {
 	return &options{
+		parent:          context.Background(),
 		shutdownTimeout: 30 * time.Second,
+		gracePeriod:     10 * time.Second,
 		logger:          slog.Default(),
-		cleaners:        make([]Cleaner, 0),
+		cleaners:        make([]namedCleaner, 0),
+		signals:         []os.Signal{syscall.SIGINT, syscall.SIGTERM},
+		recover:         true,
 	}
 }
 
@@ -35,6 +54,55 @@ func WithTimeout(d time.Duration) Option {
 	}
 }
 
+// WithGracePeriod sets how long Run waits for the task to return after a
+// shutdown signal is received. If the task is still running when the grace
+// period expires, Run proceeds to cleanup and returns ErrTaskTimeout.
+// Default is 10 seconds.
+func WithGracePeriod(d time.Duration) Option {
+	return func(o *options) {
+		o.gracePeriod = d
+	}
+}
+
+// WithRecover controls whether Run recovers from a panic in the task.
+// When enabled, the panic is logged with its stack trace, cleanup still runs,
+// and Run returns an error wrapping ErrTaskPanic and the panic value.
+// When disabled, a panic in the task crashes the process without cleanup.
+// Default is true.
+func WithRecover(enabled bool) Option {
+	return func(o *options) {
+		o.recover = enabled
+	}
+}
+
+// WithContext sets the parent context of the task context. Cancelling the parent
+// triggers graceful shutdown exactly like a signal does, which lets the manager be
+// embedded in a larger application with its own lifecycle.
+// Values of the parent are still visible to cleaners, but its cancellation is not,
+// so cleanup always gets the full shutdown timeout.
+// Default is context.Background().
+func WithContext(ctx context.Context) Option {
+	return func(o *options) {
+		if ctx != nil {
+			o.parent = ctx
+		}
+	}
+}
+
+// WithSignals overrides the set of signals that trigger shutdown.
+// Default is SIGINT and SIGTERM. Calling it without arguments keeps the default,
+// because signal.NotifyContext with no signals would relay every incoming signal.
+// Example:
+//
+//	graceful.Run(task, graceful.WithSignals(syscall.SIGTERM, syscall.SIGHUP))
+func WithSignals(sigs ...os.Signal) Option {
+	return func(o *options) {
+		if len(sigs) > 0 {
+			o.signals = sigs
+		}
+	}
+}
+
 // WithLogger sets the logger used by the manager.
 // Accepts *slog.Logger.
 func WithLogger(l *slog.Logger) Option {
@@ -48,9 +116,36 @@ func WithLogger(l *slog.Logger) Option {
 // WithCleanup adds a cleanup function to be executed during shutdown.
 // Cleanup functions are executed in LIFO order.
 func WithCleanup(c Cleaner) Option {
+	return WithNamedCleanup("", c)
+}
+
+// WithNamedCleanup adds a named cleanup function to be executed during shutdown.
+// The name is included in the "cleanup failed" log and in the returned error,
+// which makes it easy to tell which resource failed during a multi-resource shutdown.
+func WithNamedCleanup(name string, c Cleaner) Option {
 	return func(o *options) {
 		if c != nil {
-			o.cleaners = append(o.cleaners, c)
+			o.cleaners = append(o.cleaners, namedCleaner{name: name, fn: c})
+		}
+	}
+}
+
+// WithParallelCleanup adds a group of independent cleanup functions that run
+// concurrently during shutdown. The group as a whole takes one slot in the LIFO
+// order, so it still runs after cleaners registered later and before cleaners
+// registered earlier. Errors from all cleaners in the group are joined.
+// Use it for resources that do not depend on each other (e.g. several connection pools),
+// so the shutdown takes roughly the slowest cleaner's time instead of the sum.
+func WithParallelCleanup(cleaners ...Cleaner) Option {
+	return func(o *options) {
+		group := make([]Cleaner, 0, len(cleaners))
+		for _, c := range cleaners {
+			if c != nil {
+				group = append(group, c)
+			}
+		}
+		if len(group) > 0 {
+			o.cleaners = append(o.cleaners, namedCleaner{fn: parallelCleaner(group)})
 		}
 	}
 }
@@ -61,21 +156,15 @@ func WithCleanup(c Cleaner) Option {
 // the manager will give up waiting and return a timeout error, but the underlying Close
 // operation will continue running in the background until it completes or the process exits.
 func WithCloser(c io.Closer) Option {
+	return WithNamedCloser("", c)
+}
+
+// WithNamedCloser adds a named io.Closer to be closed during shutdown.
+// See WithCloser and WithNamedCleanup.
+func WithNamedCloser(name string, c io.Closer) Option {
 	return func(o *options) {
 		if c != nil {
-			o.cleaners = append(o.cleaners, func(ctx context.Context) error {
-				done := make(chan error, 1)
-				go func() {
-					done <- c.Close()
-				}()
-
-				select {
-				case err := <-done:
-					return err
-				case <-ctx.Done():
-					return fmt.Errorf("closer (%T) timed out: %w", c, ctx.Err())
-				}
-			})
+			o.cleaners = append(o.cleaners, namedCleaner{name: name, fn: closerCleaner(c)})
 		}
 	}
 }
@@ -99,22 +188,75 @@ func WithClosers(closers ...io.Closer) Option {
 	return func(o *options) {
 		for _, c := range closers {
 			if c != nil {
-				// Copy variable to avoid closure capture issue
-				closer := c
-				o.cleaners = append(o.cleaners, func(ctx context.Context) error {
-					done := make(chan error, 1)
-					go func() {
-						done <- closer.Close()
-					}()
+				o.cleaners = append(o.cleaners, namedCleaner{fn: closerCleaner(c)})
+			}
+		}
+	}
+}
 
+// ServeHTTP runs srv.ListenAndServe alongside the task and registers srv.Shutdown
+// as a named cleanup in one call, so the server is always shut down within the
+// manager's timeout (see WithTimeout). Like any cleaner, the shutdown takes its
+// place in the LIFO order. If the server fails (e.g. the port is already in use),
+// shutdown is triggered as if a signal was received and Run returns the error.
+// Example:
+//
+//	srv := &http.Server{Addr: ":8080", Handler: mux}
+//	graceful.Run(nil, graceful.ServeHTTP(srv), graceful.WithCloser(db))
+func ServeHTTP(srv *http.Server) Option {
+	return func(o *options) {
+		if srv != nil {
+			o.services = append(o.services, HTTPTask(srv))
+			o.cleaners = append(o.cleaners, namedCleaner{name: "http server " + srv.Addr, fn: srv.Shutdown})
+		}
+	}
+}
+
+// closerCleaner wraps an io.Closer as a Cleaner that gives up waiting when ctx is done.
+func closerCleaner(c io.Closer) Cleaner {
+	return func(ctx context.Context) error {
+		done := make(chan error, 1)
+		go func() {
+			done <- c.Close()
+		}()
+
+		select {
+		case err := <-done:
+			return err
+		case <-ctx.Done():
+			return fmt.Errorf("closer (%T) timed out: %w", c, ctx.Err())
+		}
+	}
+}
+
+// parallelCleaner runs cleaners concurrently and joins their errors.
+// It stops waiting when ctx is done, so a cleaner that ignores ctx cannot exceed the shutdown timeout;
+// errors from cleaners that already finished are still joined with the timeout error.
+func parallelCleaner(cleaners []Cleaner) Cleaner {
+	return func(ctx context.Context) error {
+		// buffered so cleaners finishing after a timeout never block
+		results := make(chan error, len(cleaners))
+		for _, c := range cleaners {
+			go func() { results <- c(ctx) }()
+		}
+
+		errs := make([]error, 0, len(cleaners))
+		for range cleaners {
+			select {
+			case err := <-results:
+				errs = append(errs, err)
+			case <-ctx.Done():
+				// collect whatever has already arrived before reporting the timeout
+				for {
 					select {
-					case err := <-done:
-						return err
-					case <-ctx.Done():
-						return fmt.Errorf("closer (%T) timed out: %w", closer, ctx.Err())
+					case err := <-results:
+						errs = append(errs, err)
+					default:
+						return errors.Join(append(errs, fmt.Errorf("parallel cleanup timed out: %w", ctx.Err()))...)
 					}
-				})
+				}
 			}
 		}
+		return errors.Join(errs...)
 	}
 }
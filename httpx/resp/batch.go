@@ -0,0 +1,34 @@
+package resp
+
+// BatchResult represents the result of a single item in a batch operation
+type BatchResult[T any] struct {
+	Index   int    `json:"index" example:"0"`
+	Success bool   `json:"success" example:"true"`
+	Data    *T     `json:"data,omitempty"`
+	Error   *Error `json:"error,omitempty"`
+}
+
+// BatchResponse represents a partial-success batch operation response
+type BatchResponse[T any] struct {
+	Results      []BatchResult[T] `json:"results"`
+	SuccessCount int              `json:"success_count" example:"2"`
+	FailureCount int              `json:"failure_count" example:"1"`
+}
+
+// NewBatchResponse 建立批次回應，並依各項結果的 Success 自動計算成功與失敗數量。
+// results 為 nil 時會轉為空 slice，確保 JSON 輸出為 [] 而非 null。
+func NewBatchResponse[T any](results []BatchResult[T]) *BatchResponse[T] {
+	if results == nil {
+		results = []BatchResult[T]{}
+	}
+
+	r := &BatchResponse[T]{Results: results}
+	for _, res := range results {
+		if res.Success {
+			r.SuccessCount++
+		} else {
+			r.FailureCount++
+		}
+	}
+	return r
+}
@@ -0,0 +1,59 @@
+package resp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewBatchResponse_Counts(t *testing.T) {
+	ok := BatchResult[string]{Success: true}
+	fail := BatchResult[string]{Success: false, Error: &Error{Code: 400, Message: "bad"}}
+
+	tests := []struct {
+		name        string
+		results     []BatchResult[string]
+		wantSuccess int
+		wantFailure int
+	}{
+		{"mixed", []BatchResult[string]{ok, fail, ok}, 2, 1},
+		{"all_success", []BatchResult[string]{ok, ok}, 2, 0},
+		{"all_failure", []BatchResult[string]{fail, fail, fail}, 0, 3},
+		{"empty", nil, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewBatchResponse(tt.results)
+			if r.SuccessCount != tt.wantSuccess || r.FailureCount != tt.wantFailure {
+				t.Errorf("counts = (%d, %d), want (%d, %d)", r.SuccessCount, r.FailureCount, tt.wantSuccess, tt.wantFailure)
+			}
+		})
+	}
+}
+
+func TestBatchResponse_JSON(t *testing.T) {
+	data := "created"
+	r := NewBatchResponse([]BatchResult[string]{
+		{Index: 0, Success: true, Data: &data},
+		{Index: 1, Success: false, Error: &Error{Code: 409, Message: "conflict"}},
+	})
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	want := `{"results":[{"index":0,"success":true,"data":"created"},` +
+		`{"index":1,"success":false,"error":{"code":409,"message":"conflict"}}],` +
+		`"success_count":1,"failure_count":1}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+
+	empty, err := json.Marshal(NewBatchResponse[int](nil))
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	if string(empty) != `{"results":[],"success_count":0,"failure_count":0}` {
+		t.Errorf("json = %s", empty)
+	}
+}
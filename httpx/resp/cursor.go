@@ -0,0 +1,52 @@
+package resp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// CursorPage represents a cursor-based pagination response
+type CursorPage[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJpZCI6MTAwfQ"`
+	PrevCursor string `json:"prev_cursor,omitempty" example:"eyJpZCI6NTF9"`
+	HasMore    bool   `json:"has_more" example:"true"`
+}
+
+// NewCursorPage 建立游標分頁回應，HasMore 依 nextCursor 是否為空決定。
+// items 為 nil 時會轉為空 slice，確保 JSON 輸出為 [] 而非 null。
+func NewCursorPage[T any](items []T, nextCursor, prevCursor string) *CursorPage[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return &CursorPage[T]{
+		Items:      items,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+		HasMore:    nextCursor != "",
+	}
+}
+
+// EncodeCursor 將任意值編碼為不透明的游標字串（JSON + base64 URL-safe，無 padding）。
+// 產生的字串可直接放在 URL query 中。
+func EncodeCursor(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeCursor 將 EncodeCursor 產生的游標字串還原為指定型別。
+func DecodeCursor[T any](s string) (T, error) {
+	var v T
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return v, fmt.Errorf("decode cursor: %w", err)
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return v, fmt.Errorf("decode cursor: %w", err)
+	}
+	return v, nil
+}
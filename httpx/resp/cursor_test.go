@@ -0,0 +1,86 @@
+package resp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewCursorPage_HasMore(t *testing.T) {
+	tests := []struct {
+		name       string
+		nextCursor string
+		want       bool
+	}{
+		{"has_next", "abc", true},
+		{"no_next", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewCursorPage([]int{1, 2}, tt.nextCursor, "")
+			if p.HasMore != tt.want {
+				t.Errorf("HasMore = %v, want %v", p.HasMore, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorPage_JSON(t *testing.T) {
+	p := NewCursorPage([]string{"a", "b"}, "next", "prev")
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	want := `{"items":["a","b"],"next_cursor":"next","prev_cursor":"prev","has_more":true}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+
+	// nil items 應輸出為 []，空游標省略
+	empty := NewCursorPage[int](nil, "", "")
+	b, err = json.Marshal(empty)
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	want = `{"items":[],"has_more":false}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	type cursor struct {
+		ID    int64  `json:"id"`
+		Order string `json:"order"`
+	}
+
+	in := cursor{ID: 100, Order: "desc"}
+	s, err := EncodeCursor(in)
+	if err != nil {
+		t.Fatalf("EncodeCursor error: %v", err)
+	}
+
+	got, err := DecodeCursor[cursor](s)
+	if err != nil {
+		t.Fatalf("DecodeCursor error: %v", err)
+	}
+	if got != in {
+		t.Errorf("DecodeCursor = %+v, want %+v", got, in)
+	}
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	if _, err := DecodeCursor[int]("!!!not-base64"); err == nil {
+		t.Error("DecodeCursor should fail for invalid base64")
+	}
+	// "bm90LWpzb24" = base64("not-json")
+	if _, err := DecodeCursor[int]("bm90LWpzb24"); err == nil {
+		t.Error("DecodeCursor should fail for invalid JSON")
+	}
+}
+
+func TestEncodeCursor_Invalid(t *testing.T) {
+	if _, err := EncodeCursor(func() {}); err == nil {
+		t.Error("EncodeCursor should fail for unsupported type")
+	}
+}
@@ -11,6 +11,44 @@
 //	    Message: "unauthorized",
 //	}
 //
+// # 驗證錯誤
+//
+// 表單驗證失敗時回報各欄位的錯誤原因（Code 422，Fields 依欄位名稱排序）：
+//
+//	body := resp.NewValidationError(map[string]string{"email": "invalid email format"})
+//	// {"code":422,"message":"validation failed","fields":[{"field":"email","reason":"invalid email format"}]}
+//
+// # 成功回應
+//
+// 與 Error 對應的成功回應結構，Data 為泛型：
+//
+//	body := resp.OK(user)
+//	// {"code":200,"message":"ok","data":{...}}
+//
+// # 批次回應
+//
+// 批次操作允許部分成功，逐項回報結果並自動計算成功 / 失敗數量：
+//
+//	batch := resp.NewBatchResponse([]resp.BatchResult[User]{
+//	    {Index: 0, Success: true, Data: &u},
+//	    {Index: 1, Success: false, Error: &resp.Error{Code: 409, Message: "conflict"}},
+//	})
+//
+// # 限流錯誤
+//
+// 寫入 429 回應並設定 Retry-After 與 X-RateLimit-* header：
+//
+//	resp.WriteRateLimitError(w, limit, remaining, resetUnix)
+//
+// # Server-Sent Events
+//
+// 以 SSE 格式串流事件，每次寫入後自動 Flush：
+//
+//	sse := resp.NewSSEWriter(w)
+//	_ = sse.WriteRetry(3000)
+//	_ = sse.WriteEvent("update", `{"id":1}`)
+//	_ = sse.WriteComment("keep-alive")
+//
 // # 健康檢查
 //
 // 健康檢查端點回應：
@@ -19,6 +57,36 @@
 //	    Status: "ok",
 //	}
 //
+// # 回應輔助函式
+//
+// 201 Created（含 Location header 與 JSON body）與 204 No Content：
+//
+//	err := resp.WriteCreated(w, "/users/42", user)
+//	resp.WriteNoContent(w)
+//
+// # API 版本
+//
+// 設定 X-API-Version header 並於 body 帶入 apiVersion 欄位：
+//
+//	resp.WriteAPIVersion(w, "v1")
+//	body := resp.NewVersionedResponse("v1", user)
+//	// {"apiVersion":"v1","data":{...}}
+//
+// # 頁碼分頁
+//
+// 以頁碼分頁，TotalPages 由 total 與 pageSize 無條件進位計算：
+//
+//	page := resp.NewPaginated(users, 1, 10, total) // total=25 → TotalPages=3
+//
+// # 游標分頁
+//
+// 以不透明游標（base64 JSON）進行分頁，HasMore 依 nextCursor 是否為空決定：
+//
+//	next, _ := resp.EncodeCursor(map[string]int64{"id": lastID})
+//	page := resp.NewCursorPage(items, next, "")
+//
+//	c, err := resp.DecodeCursor[map[string]int64](r.URL.Query().Get("cursor"))
+//
 // # Swagger 標籤
 //
 // 所有結構都包含 json 與 example 標籤，方便 Swagger 文檔生成：
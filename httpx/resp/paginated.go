@@ -0,0 +1,31 @@
+package resp
+
+// Paginated represents an offset-based (page number) pagination response
+type Paginated[T any] struct {
+	Items      []T   `json:"items"`
+	Page       int   `json:"page" example:"1"`
+	PageSize   int   `json:"page_size" example:"10"`
+	Total      int64 `json:"total" example:"25"`
+	TotalPages int   `json:"total_pages" example:"3"`
+}
+
+// NewPaginated 建立頁碼分頁回應，TotalPages 以 total / pageSize 無條件進位計算（25 筆、每頁 10 筆 → 3 頁）。
+// total 為 0 或 pageSize <= 0 時 TotalPages 為 0。
+// items 為 nil 時會轉為空 slice，確保 JSON 輸出為 [] 而非 null。
+func NewPaginated[T any](items []T, page, pageSize int, total int64) *Paginated[T] {
+	if items == nil {
+		items = []T{}
+	}
+
+	var totalPages int
+	if total > 0 && pageSize > 0 {
+		totalPages = int((total + int64(pageSize) - 1) / int64(pageSize))
+	}
+	return &Paginated[T]{
+		Items:      items,
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
@@ -0,0 +1,51 @@
+package resp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewPaginated_TotalPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		pageSize int
+		total    int64
+		want     int
+	}{
+		{"round_up", 10, 25, 3},
+		{"exact", 10, 30, 3},
+		{"less_than_one_page", 10, 3, 1},
+		{"one_item", 1, 1, 1},
+		{"zero_total", 10, 0, 0},
+		{"zero_page_size", 0, 25, 0},
+		{"negative_page_size", -10, 25, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPaginated([]int{1}, 1, tt.pageSize, tt.total)
+			if p.TotalPages != tt.want {
+				t.Errorf("TotalPages = %d, want %d", p.TotalPages, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginated_JSON(t *testing.T) {
+	b, err := json.Marshal(NewPaginated([]string{"a", "b"}, 3, 10, 25))
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	want := `{"items":["a","b"],"page":3,"page_size":10,"total":25,"total_pages":3}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+
+	empty, err := json.Marshal(NewPaginated[int](nil, 1, 10, 0))
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	if string(empty) != `{"items":[],"page":1,"page_size":10,"total":0,"total_pages":0}` {
+		t.Errorf("json = %s", empty)
+	}
+}
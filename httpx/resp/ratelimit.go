@@ -0,0 +1,44 @@
+package resp
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitError represents a rate limit exceeded error response
+type RateLimitError struct {
+	Code       int    `json:"code" example:"429"`
+	Message    string `json:"message" example:"too many requests"`
+	RetryAfter int    `json:"retry_after" example:"30"`
+	Limit      int    `json:"limit" example:"100"`
+	Remaining  int    `json:"remaining" example:"0"`
+	Reset      int64  `json:"reset" example:"1735689600"`
+}
+
+// WriteRateLimitError 寫入 429 Too Many Requests 回應（JSON body），並設定以下 header：
+//   - Retry-After:           距離 resetUnix 的秒數（最少 0）
+//   - X-RateLimit-Limit:     limit
+//   - X-RateLimit-Remaining: remaining
+//   - X-RateLimit-Reset:     resetUnix（Unix 秒數）
+func WriteRateLimitError(w http.ResponseWriter, limit, remaining int, resetUnix int64) {
+	retryAfter := max(int(resetUnix-time.Now().Unix()), 0)
+
+	body := RateLimitError{
+		Code:       http.StatusTooManyRequests,
+		Message:    "too many requests",
+		RetryAfter: retryAfter,
+		Limit:      limit,
+		Remaining:  remaining,
+		Reset:      resetUnix,
+	}
+
+	h := w.Header()
+	h.Set("Retry-After", strconv.Itoa(retryAfter))
+	h.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(resetUnix, 10))
+
+	// RateLimitError 僅含基本型別，序列化不會失敗；寫入錯誤僅代表客戶端已斷線
+	_ = writeJSON(w, http.StatusTooManyRequests, body)
+}
@@ -0,0 +1,60 @@
+package resp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWriteRateLimitError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	reset := time.Now().Add(30 * time.Second).Unix()
+
+	WriteRateLimitError(rec, 100, 0, reset)
+
+	if rec.Code != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+
+	headers := map[string]string{
+		"X-RateLimit-Limit":     "100",
+		"X-RateLimit-Remaining": "0",
+		"X-RateLimit-Reset":     strconv.FormatInt(reset, 10),
+	}
+	for k, want := range headers {
+		if got := rec.Header().Get(k); got != want {
+			t.Errorf("header %s = %q, want %q", k, got, want)
+		}
+	}
+
+	retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+	if err != nil {
+		t.Fatalf("Retry-After is not an integer: %v", err)
+	}
+	if retryAfter < 29 || retryAfter > 30 {
+		t.Errorf("Retry-After = %d, want ~30", retryAfter)
+	}
+
+	var body RateLimitError
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("body is not valid JSON: %v", err)
+	}
+	if body.Code != http.StatusTooManyRequests || body.Limit != 100 || body.Remaining != 0 || body.Reset != reset {
+		t.Errorf("unexpected body: %+v", body)
+	}
+	if body.RetryAfter != retryAfter {
+		t.Errorf("body.RetryAfter = %d, want %d", body.RetryAfter, retryAfter)
+	}
+}
+
+func TestWriteRateLimitError_PastReset(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteRateLimitError(rec, 10, 0, time.Now().Add(-time.Minute).Unix())
+
+	if got := rec.Header().Get("Retry-After"); got != "0" {
+		t.Errorf("Retry-After = %q, want %q", got, "0")
+	}
+}
@@ -0,0 +1,105 @@
+package resp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidSSEField 表示 SSE 欄位值包含換行字元（event 名稱不可跨行）。
+var ErrInvalidSSEField = errors.New("resp: invalid SSE field value")
+
+// SSEWriter 以 Server-Sent Events（text/event-stream）格式寫入串流回應。
+// 每次寫入後，若底層 ResponseWriter 支援 http.Flusher 會立即 Flush。
+type SSEWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewSSEWriter 建立 SSEWriter，並設定 SSE 所需的 header：
+//   - Content-Type: text/event-stream
+//   - Cache-Control: no-cache
+//   - X-Accel-Buffering: no（停用 Nginx 代理緩衝）
+//
+// 需在第一次寫入前呼叫。
+func NewSSEWriter(w http.ResponseWriter) *SSEWriter {
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("X-Accel-Buffering", "no")
+
+	flusher, _ := w.(http.Flusher)
+	return &SSEWriter{w: w, flusher: flusher}
+}
+
+// WriteEvent 寫入具名事件（event: 欄位 + data: 欄位）。
+// event 不可包含換行；data 可包含多行，會拆成多個 data: 欄位。
+func (s *SSEWriter) WriteEvent(event, data string) error {
+	if strings.ContainsAny(event, "\r\n") {
+		return fmt.Errorf("%w: event %q", ErrInvalidSSEField, event)
+	}
+
+	var b strings.Builder
+	b.WriteString("event: ")
+	b.WriteString(event)
+	b.WriteByte('\n')
+	writeSSEData(&b, data)
+	b.WriteByte('\n')
+	return s.write(b.String())
+}
+
+// WriteData 寫入未命名事件（僅 data: 欄位），客戶端以 message 事件接收。
+func (s *SSEWriter) WriteData(data string) error {
+	var b strings.Builder
+	writeSSEData(&b, data)
+	b.WriteByte('\n')
+	return s.write(b.String())
+}
+
+// WriteRetry 設定客戶端斷線後的重新連線間隔（毫秒）。
+func (s *SSEWriter) WriteRetry(ms int) error {
+	return s.write("retry: " + strconv.Itoa(ms) + "\n\n")
+}
+
+// WriteComment 寫入註解行（: 開頭），常用於 keep-alive 心跳。
+// comment 可包含多行，每行各自加上 : 前綴。
+func (s *SSEWriter) WriteComment(comment string) error {
+	var b strings.Builder
+	for _, line := range splitSSELines(comment) {
+		b.WriteString(": ")
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	return s.write(b.String())
+}
+
+// write 寫入原始內容並 Flush。
+func (s *SSEWriter) write(msg string) error {
+	if _, err := io.WriteString(s.w, msg); err != nil {
+		return err
+	}
+	if s.flusher != nil {
+		s.flusher.Flush()
+	}
+	return nil
+}
+
+// writeSSEData 將 data 依行拆成多個 data: 欄位。
+func writeSSEData(b *strings.Builder, data string) {
+	for _, line := range splitSSELines(data) {
+		b.WriteString("data: ")
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+}
+
+// splitSSELines 依 SSE 規範的換行（\r\n、\r、\n）拆行。
+func splitSSELines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,63 @@
+package resp
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewSSEWriter_Headers(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewSSEWriter(rec)
+
+	headers := map[string]string{
+		"Content-Type":      "text/event-stream",
+		"Cache-Control":     "no-cache",
+		"X-Accel-Buffering": "no",
+	}
+	for k, want := range headers {
+		if got := rec.Header().Get(k); got != want {
+			t.Errorf("header %s = %q, want %q", k, got, want)
+		}
+	}
+}
+
+func TestSSEWriter_Format(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(s *SSEWriter) error
+		want  string
+	}{
+		{"event", func(s *SSEWriter) error { return s.WriteEvent("update", `{"id":1}`) }, "event: update\ndata: {\"id\":1}\n\n"},
+		{"data", func(s *SSEWriter) error { return s.WriteData("hello") }, "data: hello\n\n"},
+		{"multiline_data", func(s *SSEWriter) error { return s.WriteData("a\nb\r\nc") }, "data: a\ndata: b\ndata: c\n\n"},
+		{"retry", func(s *SSEWriter) error { return s.WriteRetry(3000) }, "retry: 3000\n\n"},
+		{"comment", func(s *SSEWriter) error { return s.WriteComment("ping") }, ": ping\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			if err := tt.write(NewSSEWriter(rec)); err != nil {
+				t.Fatalf("write error: %v", err)
+			}
+			if got := rec.Body.String(); got != tt.want {
+				t.Errorf("body = %q, want %q", got, tt.want)
+			}
+			if !rec.Flushed {
+				t.Error("writer should be flushed after each write")
+			}
+		})
+	}
+}
+
+func TestSSEWriter_InvalidEvent(t *testing.T) {
+	rec := httptest.NewRecorder()
+	err := NewSSEWriter(rec).WriteEvent("bad\nevent", "data")
+	if !errors.Is(err, ErrInvalidSSEField) {
+		t.Fatalf("error = %v, want ErrInvalidSSEField", err)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("body should be empty, got %q", rec.Body.String())
+	}
+}
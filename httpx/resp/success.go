@@ -0,0 +1,22 @@
+package resp
+
+import "net/http"
+
+// Success represents a standard API success response
+type Success[T any] struct {
+	Code    int    `json:"code" example:"200"`
+	Message string `json:"message" example:"ok"`
+	Data    T      `json:"data"`
+}
+
+// OK 建立 Code 為 200、Message 為 "ok" 的成功回應，與 Error 搭配作為統一的回應格式。
+//
+//	c.JSON(http.StatusOK, resp.OK(user))
+//	// {"code":200,"message":"ok","data":{...}}
+func OK[T any](data T) Success[T] {
+	return Success[T]{
+		Code:    http.StatusOK,
+		Message: "ok",
+		Data:    data,
+	}
+}
@@ -0,0 +1,46 @@
+package resp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOK_JSON(t *testing.T) {
+	type user struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	b, err := json.Marshal(OK(user{ID: 42, Name: "amy"}))
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	want := `{"code":200,"message":"ok","data":{"id":42,"name":"amy"}}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func TestOK_Data(t *testing.T) {
+	tests := []struct {
+		name string
+		body any
+		want string
+	}{
+		{"slice", OK([]int{1, 2}), `{"code":200,"message":"ok","data":[1,2]}`},
+		{"nil_pointer", OK[*int](nil), `{"code":200,"message":"ok","data":null}`},
+		{"string", OK("done"), `{"code":200,"message":"ok","data":"done"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.body)
+			if err != nil {
+				t.Fatalf("json.Marshal error: %v", err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("json = %s, want %s", b, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package resp
+
+import (
+	"net/http"
+	"sort"
+)
+
+// FieldError represents a validation error of a single field
+type FieldError struct {
+	Field  string `json:"field" example:"email"`
+	Reason string `json:"reason" example:"invalid email format"`
+}
+
+// ValidationError represents a validation error response with per-field details
+type ValidationError struct {
+	Error
+	Fields []FieldError `json:"fields"`
+}
+
+// NewValidationError 以欄位名稱 → 錯誤原因的 map 建立驗證錯誤回應，
+// Code 為 422、Message 為 "validation failed"。Fields 依欄位名稱排序，確保輸出穩定；
+// fields 為空時 Fields 為空 slice，JSON 輸出為 [] 而非 null。
+//
+//	body := resp.NewValidationError(map[string]string{
+//	    "email": "invalid email format",
+//	    "age":   "must be at least 18",
+//	})
+//	// {"code":422,"message":"validation failed","fields":[{"field":"age",...},{"field":"email",...}]}
+func NewValidationError(fields map[string]string) *ValidationError {
+	fe := make([]FieldError, 0, len(fields))
+	for field, reason := range fields {
+		fe = append(fe, FieldError{Field: field, Reason: reason})
+	}
+	sort.Slice(fe, func(i, j int) bool { return fe[i].Field < fe[j].Field })
+
+	return &ValidationError{
+		Error:  Error{Code: http.StatusUnprocessableEntity, Message: "validation failed"},
+		Fields: fe,
+	}
+}
@@ -0,0 +1,65 @@
+package resp
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewValidationError_JSON(t *testing.T) {
+	v := NewValidationError(map[string]string{
+		"email": "invalid email format",
+		"age":   "must be at least 18",
+		"name":  "required",
+	})
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	want := `{"code":422,"message":"validation failed","fields":[` +
+		`{"field":"age","reason":"must be at least 18"},` +
+		`{"field":"email","reason":"invalid email format"},` +
+		`{"field":"name","reason":"required"}]}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func TestNewValidationError_Empty(t *testing.T) {
+	b, err := json.Marshal(NewValidationError(nil))
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	if want := `{"code":422,"message":"validation failed","fields":[]}`; string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func TestValidationError_Tags(t *testing.T) {
+	tests := []struct {
+		typ         reflect.Type
+		field       string
+		wantJSON    string
+		wantExample string
+	}{
+		{reflect.TypeOf(FieldError{}), "Field", "field", "email"},
+		{reflect.TypeOf(FieldError{}), "Reason", "reason", "invalid email format"},
+		{reflect.TypeOf(ValidationError{}), "Fields", "fields", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ.Name()+"."+tt.field, func(t *testing.T) {
+			f, ok := tt.typ.FieldByName(tt.field)
+			if !ok {
+				t.Fatalf("field %s not found", tt.field)
+			}
+			if got := f.Tag.Get("json"); got != tt.wantJSON {
+				t.Errorf("json tag = %q, want %q", got, tt.wantJSON)
+			}
+			if got := f.Tag.Get("example"); got != tt.wantExample {
+				t.Errorf("example tag = %q, want %q", got, tt.wantExample)
+			}
+		})
+	}
+}
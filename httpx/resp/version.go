@@ -0,0 +1,19 @@
+package resp
+
+import "net/http"
+
+// VersionedResponse represents a response wrapper that carries the API version
+type VersionedResponse[T any] struct {
+	APIVersion string `json:"apiVersion" example:"v1"`
+	Data       T      `json:"data"`
+}
+
+// NewVersionedResponse 建立帶有 API 版本的回應。
+func NewVersionedResponse[T any](version string, data T) *VersionedResponse[T] {
+	return &VersionedResponse[T]{APIVersion: version, Data: data}
+}
+
+// WriteAPIVersion 設定 X-API-Version header，需在寫入 status 與 body 前呼叫。
+func WriteAPIVersion(w http.ResponseWriter, version string) {
+	w.Header().Set("X-API-Version", version)
+}
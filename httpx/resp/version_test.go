@@ -0,0 +1,41 @@
+package resp
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteAPIVersion(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteAPIVersion(rec, "v2")
+
+	if got := rec.Header().Get("X-API-Version"); got != "v2" {
+		t.Errorf("X-API-Version = %q, want %q", got, "v2")
+	}
+}
+
+func TestVersionedResponse_JSON(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteAPIVersion(rec, "v1")
+	if err := writeJSON(rec, 200, NewVersionedResponse("v1", map[string]int{"id": 1})); err != nil {
+		t.Fatalf("writeJSON error: %v", err)
+	}
+
+	if got := rec.Header().Get("X-API-Version"); got != "v1" {
+		t.Errorf("X-API-Version = %q, want %q", got, "v1")
+	}
+
+	want := `{"apiVersion":"v1","data":{"id":1}}`
+	if got := rec.Body.String(); got != want {
+		t.Errorf("json = %s, want %s", got, want)
+	}
+
+	var decoded VersionedResponse[map[string]int]
+	if err := json.Unmarshal(rec.Body.Bytes(), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if decoded.APIVersion != "v1" || decoded.Data["id"] != 1 {
+		t.Errorf("decoded = %+v", decoded)
+	}
+}
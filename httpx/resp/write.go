@@ -0,0 +1,34 @@
+package resp
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// writeJSON 將 body 序列化為 JSON 並連同狀態碼寫入回應。
+// 先完成序列化再寫 header，序列化失敗時不會送出任何內容，呼叫端仍可改寫錯誤回應。
+func writeJSON(w http.ResponseWriter, status int, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, err = w.Write(b)
+	return err
+}
+
+// WriteCreated 寫入 201 Created 回應，設定 Location header 指向新建立的資源，並以 JSON 輸出 body。
+// location 為空時不設定 Location header。
+func WriteCreated(w http.ResponseWriter, location string, body any) error {
+	if location != "" {
+		w.Header().Set("Location", location)
+	}
+	return writeJSON(w, http.StatusCreated, body)
+}
+
+// WriteNoContent 寫入 204 No Content 回應（無 body）。
+func WriteNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
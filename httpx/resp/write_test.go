@@ -0,0 +1,57 @@
+package resp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteCreated(t *testing.T) {
+	rec := httptest.NewRecorder()
+	body := map[string]any{"id": 42, "name": "foo"}
+
+	if err := WriteCreated(rec, "/users/42", body); err != nil {
+		t.Fatalf("WriteCreated error: %v", err)
+	}
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusCreated)
+	}
+	if got := rec.Header().Get("Location"); got != "/users/42" {
+		t.Errorf("Location = %q, want %q", got, "/users/42")
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/json; charset=utf-8" {
+		t.Errorf("Content-Type = %q", got)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("body is not valid JSON: %v", err)
+	}
+	if got["id"] != float64(42) || got["name"] != "foo" {
+		t.Errorf("unexpected body: %v", got)
+	}
+}
+
+func TestWriteCreated_MarshalError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	if err := WriteCreated(rec, "/x", func() {}); err == nil {
+		t.Fatal("WriteCreated should fail for unsupported body")
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("body should be empty on marshal error, got %q", rec.Body.String())
+	}
+}
+
+func TestWriteNoContent(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteNoContent(rec)
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("body should be empty, got %q", rec.Body.String())
+	}
+}
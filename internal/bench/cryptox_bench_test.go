@@ -0,0 +1,42 @@
+package bench
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vincent119/commons/cryptox"
+)
+
+// BenchmarkSecureEqual 比較相同、第一個 byte 不同與最後一個 byte 不同的輸入，
+// 三者的 ns/op 應相近（bytes.Equal 作為會提早返回的對照組）。
+func BenchmarkSecureEqual(b *testing.B) {
+	a := bytes.Repeat([]byte{0xab}, 4096)
+	equal := bytes.Clone(a)
+	diffFirst := bytes.Clone(a)
+	diffFirst[0] ^= 0xff
+	diffLast := bytes.Clone(a)
+	diffLast[len(diffLast)-1] ^= 0xff
+
+	inputs := []struct {
+		name  string
+		other []byte
+	}{
+		{"equal", equal},
+		{"differ_first", diffFirst},
+		{"differ_last", diffLast},
+	}
+	for _, in := range inputs {
+		b.Run("SecureEqual/"+in.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = cryptox.SecureEqual(a, in.other)
+			}
+		})
+	}
+	for _, in := range inputs {
+		b.Run("bytes.Equal/"+in.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = bytes.Equal(a, in.other)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package bench
 
 import (
-	"github.com/vincent119/commons/stringx"
+	"fmt"
+	"strings"
 	"testing"
+
+	"github.com/vincent119/commons/stringx"
 )
 
 func BenchmarkToSnake(b *testing.B) {
@@ -14,3 +17,108 @@ func BenchmarkToSnake(b *testing.B) {
 		}
 	}
 }
+
+// naiveSplitAndTrim 為 SplitAndTrim 取代的常見寫法，作為比較基準。
+func naiveSplitAndTrim(s, sep string) []string {
+	var out []string
+	for _, p := range strings.Split(s, sep) {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func BenchmarkSplitAndTrim(b *testing.B) {
+	in := " read, write ,, admin,  billing , audit,reports ,"
+
+	b.Run("naive", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = naiveSplitAndTrim(in, ",")
+		}
+	})
+	b.Run("SplitAndTrim", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = stringx.SplitAndTrim(in, ",")
+		}
+	})
+	b.Run("quoted", func(b *testing.B) {
+		b.ReportAllocs()
+		opts := stringx.SplitOptions{Quoted: true}
+		for i := 0; i < b.N; i++ {
+			_ = stringx.SplitAndTrimWithOptions(`a, "b, c", d, "e ""f""", g`, ",", opts)
+		}
+	})
+}
+
+func BenchmarkLevenshtein_100(b *testing.B) {
+	inputs := []struct {
+		name string
+		a, b string
+	}{
+		{"ascii", strings.Repeat("abcdefghij", 10), strings.Repeat("abcdefghiz", 10)},
+		{"cjk", strings.Repeat("台北市信義區松高路", 11), strings.Repeat("台北市大安區松高路", 11)},
+	}
+
+	for _, in := range inputs {
+		b.Run(in.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = stringx.Levenshtein(in.a, in.b)
+			}
+		})
+	}
+}
+
+func BenchmarkClosestMatch(b *testing.B) {
+	candidates := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		candidates = append(candidates, strings.Repeat(string(rune('a'+i%26)), 10+i%5)+"-command")
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = stringx.ClosestMatch("mmmmmmmmmmmm-comand", candidates, 3)
+	}
+}
+
+func BenchmarkSanitizeForLog(b *testing.B) {
+	clean := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko)"
+	dirty := "Mozilla/5.0\r\nINFO forged entry \x1b[31m  (X11;\tLinux x86_64)\x00"
+
+	b.Run("clean", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = stringx.SanitizeForLog(clean, 256)
+		}
+	})
+	b.Run("dirty", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = stringx.SanitizeForLog(dirty, 256)
+		}
+	})
+}
+
+func BenchmarkCommonPrefix_10k(b *testing.B) {
+	names := make([]string, 10000)
+	for i := range names {
+		names[i] = fmt.Sprintf("exporter_http_request_duration_seconds_bucket_%d", i)
+	}
+
+	b.Run("LongestCommonPrefix", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = stringx.LongestCommonPrefix(names...)
+		}
+	})
+	b.Run("CommonPrefixByRune", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = stringx.CommonPrefixByRune(names...)
+		}
+	})
+}
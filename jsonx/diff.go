@@ -0,0 +1,130 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// JSONDiff.Type 的值。
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+)
+
+// JSONDiff 為兩份 JSON 之間的一筆差異。
+type JSONDiff struct {
+	// Path 為以 . 連接的欄位路徑，陣列元素以索引表示（與 FlattenJSON 相同，例如 "items.0.price"）；
+	// 最上層的值本身不同時為空字串。
+	Path string
+	// Type 為 DiffAdded、DiffRemoved 或 DiffChanged。
+	Type string
+	// OldValue 為 a 中的值（added 時為 nil），數字為 json.Number。
+	OldValue any
+	// NewValue 為 b 中的值（removed 時為 nil），數字為 json.Number。
+	NewValue any
+}
+
+// DiffJSON 比較兩份 JSON 並回傳差異（依 Path 排序），適用於稽核紀錄欄位變更：
+//   - 物件遞迴比較，只在 b 出現的 key 為 added，只在 a 出現的為 removed
+//   - 陣列逐一比較索引，長度不同時多出的元素為 added 或 removed
+//   - 型別不同（例如字串 → 數字、物件 → 陣列）視為整個值 changed，不再往下比較
+//   - 數值相等即視為相同（1 與 1.0 相同）
+//
+// 兩者相同時回傳空 slice。
+func DiffJSON(a, b []byte) ([]JSONDiff, error) {
+	av, err := decodeValue(a)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: diff a: %w", err)
+	}
+	bv, err := decodeValue(b)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: diff b: %w", err)
+	}
+
+	diffs := []JSONDiff{}
+	diffValues("", av, bv, &diffs)
+	sort.SliceStable(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+	return diffs, nil
+}
+
+// diffValues 比較 path 上的兩個值，將差異附加到 diffs。
+func diffValues(path string, a, b any, diffs *[]JSONDiff) {
+	switch av := a.(type) {
+	case map[string]any:
+		if bv, ok := b.(map[string]any); ok {
+			for k, aChild := range av {
+				if bChild, exists := bv[k]; exists {
+					diffValues(joinPath(path, k), aChild, bChild, diffs)
+				} else {
+					*diffs = append(*diffs, JSONDiff{Path: joinPath(path, k), Type: DiffRemoved, OldValue: aChild})
+				}
+			}
+			for k, bChild := range bv {
+				if _, exists := av[k]; !exists {
+					*diffs = append(*diffs, JSONDiff{Path: joinPath(path, k), Type: DiffAdded, NewValue: bChild})
+				}
+			}
+			return
+		}
+	case []any:
+		if bv, ok := b.([]any); ok {
+			for i := 0; i < max(len(av), len(bv)); i++ {
+				p := joinPath(path, strconv.Itoa(i))
+				switch {
+				case i >= len(bv):
+					*diffs = append(*diffs, JSONDiff{Path: p, Type: DiffRemoved, OldValue: av[i]})
+				case i >= len(av):
+					*diffs = append(*diffs, JSONDiff{Path: p, Type: DiffAdded, NewValue: bv[i]})
+				default:
+					diffValues(p, av[i], bv[i], diffs)
+				}
+			}
+			return
+		}
+	default:
+		if scalarEqual(a, b) {
+			return
+		}
+	}
+	*diffs = append(*diffs, JSONDiff{Path: path, Type: DiffChanged, OldValue: a, NewValue: b})
+}
+
+// scalarEqual 比較兩個非容器的 JSON 值；數字以精確的數值比較（1.0 與 1 相同）。
+func scalarEqual(a, b any) bool {
+	an, aNum := a.(json.Number)
+	bn, bNum := b.(json.Number)
+	if aNum && bNum {
+		if an == bn {
+			return true
+		}
+		// 精確比較（不經 float64），避免超過 2^53 的整數 ID 被視為相同
+		ai, aErr := an.Int64()
+		bi, bErr := bn.Int64()
+		if aErr == nil && bErr == nil {
+			return ai == bi
+		}
+		ar, aOK := new(big.Rat).SetString(an.String())
+		br, bOK := new(big.Rat).SetString(bn.String())
+		return aOK && bOK && ar.Cmp(br) == 0
+	}
+	if aNum || bNum {
+		return false
+	}
+	switch b.(type) {
+	case map[string]any, []any:
+		return false
+	}
+	return a == b
+}
+
+// joinPath 以 . 連接路徑片段。
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + DefaultFlattenSeparator + key
+}
@@ -0,0 +1,83 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiffJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []JSONDiff
+	}{
+		{"identical", `{"a":1,"b":{"c":[1,2]}}`, `{"b":{"c":[1,2]},"a":1}`, []JSONDiff{}},
+		{"added_top_level", `{"a":1}`, `{"a":1,"b":"new"}`, []JSONDiff{
+			{Path: "b", Type: DiffAdded, NewValue: "new"},
+		}},
+		{"removed", `{"a":1,"b":2}`, `{"a":1}`, []JSONDiff{
+			{Path: "b", Type: DiffRemoved, OldValue: json.Number("2")},
+		}},
+		{"nested_changed", `{"user":{"name":"amy","addr":{"city":"Taipei"}}}`, `{"user":{"name":"amy","addr":{"city":"Tainan"}}}`, []JSONDiff{
+			{Path: "user.addr.city", Type: DiffChanged, OldValue: "Taipei", NewValue: "Tainan"},
+		}},
+		{"array_grow", `{"tags":["a"]}`, `{"tags":["a","b","c"]}`, []JSONDiff{
+			{Path: "tags.1", Type: DiffAdded, NewValue: "b"},
+			{Path: "tags.2", Type: DiffAdded, NewValue: "c"},
+		}},
+		{"array_shrink", `{"tags":["a","b"]}`, `{"tags":["x"]}`, []JSONDiff{
+			{Path: "tags.0", Type: DiffChanged, OldValue: "a", NewValue: "x"},
+			{Path: "tags.1", Type: DiffRemoved, OldValue: "b"},
+		}},
+		{"array_of_objects", `{"items":[{"id":1,"qty":2}]}`, `{"items":[{"id":1,"qty":3}]}`, []JSONDiff{
+			{Path: "items.0.qty", Type: DiffChanged, OldValue: json.Number("2"), NewValue: json.Number("3")},
+		}},
+		{"type_change_string_to_number", `{"age":"18"}`, `{"age":18}`, []JSONDiff{
+			{Path: "age", Type: DiffChanged, OldValue: "18", NewValue: json.Number("18")},
+		}},
+		{"type_change_object_to_array", `{"v":{"a":1}}`, `{"v":[1]}`, []JSONDiff{
+			{Path: "v", Type: DiffChanged, OldValue: map[string]any{"a": json.Number("1")}, NewValue: []any{json.Number("1")}},
+		}},
+		{"null_to_value", `{"v":null}`, `{"v":false}`, []JSONDiff{
+			{Path: "v", Type: DiffChanged, OldValue: nil, NewValue: false},
+		}},
+		{"number_equal", `{"n":1}`, `{"n":1.0}`, []JSONDiff{}},
+		{"number_exponent_equal", `{"n":1000}`, `{"n":1e3}`, []JSONDiff{}},
+		{"large_int_changed", `{"id":9007199254740992}`, `{"id":9007199254740993}`, []JSONDiff{
+			{Path: "id", Type: DiffChanged, OldValue: json.Number("9007199254740992"), NewValue: json.Number("9007199254740993")},
+		}},
+		{"beyond_int64_changed", `{"id":12345678901234567890}`, `{"id":12345678901234567891}`, []JSONDiff{
+			{Path: "id", Type: DiffChanged, OldValue: json.Number("12345678901234567890"), NewValue: json.Number("12345678901234567891")},
+		}},
+		{"root_scalar", `1`, `2`, []JSONDiff{
+			{Path: "", Type: DiffChanged, OldValue: json.Number("1"), NewValue: json.Number("2")},
+		}},
+		{"sorted_by_path", `{"z":1,"a":1}`, `{"z":2,"a":2,"m":0}`, []JSONDiff{
+			{Path: "a", Type: DiffChanged, OldValue: json.Number("1"), NewValue: json.Number("2")},
+			{Path: "m", Type: DiffAdded, NewValue: json.Number("0")},
+			{Path: "z", Type: DiffChanged, OldValue: json.Number("1"), NewValue: json.Number("2")},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffJSON([]byte(tt.a), []byte(tt.b))
+			if err != nil {
+				t.Fatalf("DiffJSON() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffJSON() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffJSON_Errors(t *testing.T) {
+	if _, err := DiffJSON([]byte(`{`), []byte(`{}`)); err == nil {
+		t.Error("DiffJSON() expected error for invalid a")
+	}
+	if _, err := DiffJSON([]byte(`{}`), []byte(`{"a":}`)); err == nil {
+		t.Error("DiffJSON() expected error for invalid b")
+	}
+}
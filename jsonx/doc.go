@@ -18,4 +18,77 @@
 //   - 手動建構 JSON 字串
 //   - Log 輸出格式化
 //   - 字串安全處理
+//
+// # 格式化
+//
+// 直接格式化已序列化的 JSON（不經過 Go 型別，key 順序不變）：
+//
+//	pretty, err := jsonx.PrettyPrint(body, "  ")
+//	compact, err := jsonx.MinifyJSON(pretty)
+//
+// # JSON 深度合併
+//
+// 以 override 覆蓋 base（例如使用者設定覆蓋預設設定），物件遞迴合併、陣列整個取代，
+// override 中為 null 的欄位會刪除該 key：
+//
+//	merged, err := jsonx.MergeJSON(
+//	    []byte(`{"db":{"host":"localhost","port":5432},"tags":["a"]}`),
+//	    []byte(`{"db":{"host":"prod.db"},"tags":["b"]}`),
+//	)
+//	// {"db":{"host":"prod.db","port":5432},"tags":["b"]}
+//
+// # JSON 攤平
+//
+// 將巢狀物件攤平為單層 map（陣列以索引表示），以及還原：
+//
+//	flat, err := jsonx.FlattenJSON([]byte(`{"a":{"b":{"c":1}},"tags":["x","y"]}`))
+//	// {"a.b.c": 1, "tags.0": "x", "tags.1": "y"}
+//	flat, err := jsonx.FlattenJSONWithSeparator(data, "/") // "a/b/c"
+//	data, err := jsonx.UnflattenJSON(flat, ".")
+//
+// # Key 命名風格
+//
+// 遞迴轉換所有物件的 key（包含陣列中的物件），使用 stringx 的大小寫轉換：
+//
+//	out, err := jsonx.NormalizeKeys([]byte(`{"userName":"amy"}`), jsonx.KeyStyleSnake)
+//	// {"user_name":"amy"}
+//
+// # 路徑取值
+//
+// 以 . 與 [n] 路徑取出巢狀值（回傳 string、float64、bool、nil、map 或 slice）：
+//
+//	v, err := jsonx.GetJSONValue(data, "user.orders[0].id")
+//	city, err := jsonx.GetJSONString(data, "user.profile.address.city")
+//	if errors.Is(err, jsonx.ErrJSONPathNotFound) { ... }
+//
+// # 延遲解析
+//
+// 欄位很多但只需要其中幾個時，LazyJSON 只切分最上層欄位，取用時才解碼：
+//
+//	doc, err := jsonx.NewLazyJSON(body)
+//	id, err := doc.GetInt("id")         // 不存在：ErrJSONPathNotFound；型別不符：ErrJSONTypeMismatch
+//	raw, ok := doc.Get("metadata")      // json.RawMessage
+//
+// # 敏感資料遮蔽
+//
+// 記錄 log 前遮蔽任意深度的敏感欄位（RedactJSONFold 不區分大小寫）：
+//
+//	out, err := jsonx.RedactJSON(body, []string{"password", "token"}, "***")
+//	out, err := jsonx.RedactJSONFold(body, []string{"authorization"}, "")
+//
+// # Form 編碼
+//
+// 將 JSON 物件轉為 url.Values（OAuth、舊式 form API），巢狀物件轉為 JSON 字串，陣列以重複 key 表示：
+//
+//	form, err := jsonx.JSONToForm([]byte(`{"grant_type":"password","scope":["read","write"]}`))
+//	// form.Encode() = "grant_type=password&scope=read&scope=write"
+//
+// # JSON 差異比較
+//
+// 比較兩個版本並列出變更的欄位（稽核紀錄）：
+//
+//	diffs, err := jsonx.DiffJSON(before, after)
+//	for _, d := range diffs {
+//	    fmt.Println(d.Type, d.Path, d.OldValue, d.NewValue) // "changed user.name amy bob"
+//	}
 package jsonx
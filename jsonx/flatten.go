@@ -0,0 +1,131 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultFlattenSeparator 為 FlattenJSON 使用的 key 分隔符。
+const DefaultFlattenSeparator = "."
+
+// ErrFlattenKeyConflict 表示 UnflattenJSON 的 key 彼此衝突（例如同時有 "a" 與 "a.b"）。
+var ErrFlattenKeyConflict = errors.New("jsonx: conflicting flattened keys")
+
+// FlattenJSON 將巢狀 JSON 物件攤平為以 . 連接路徑的單層 map，適用於 log 處理與 Elasticsearch 索引：
+// {"a":{"b":{"c":1}}} → {"a.b.c": 1}；陣列元素以索引作為路徑（"a.0"、"a.1"）。
+//
+// 數字以 json.Number 保留原始字面值（避免大整數失去精度）；空物件與空陣列保留為值。
+// 最上層必須是物件，否則回傳 ErrNotObject。
+func FlattenJSON(data []byte) (map[string]any, error) {
+	return FlattenJSONWithSeparator(data, DefaultFlattenSeparator)
+}
+
+// FlattenJSONWithSeparator 同 FlattenJSON，但以 sep（例如 "/" 或 "_"）連接路徑；sep 為空時使用 "."。
+func FlattenJSONWithSeparator(data []byte, sep string) (map[string]any, error) {
+	if sep == "" {
+		sep = DefaultFlattenSeparator
+	}
+	obj, err := decodeObject(data)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: flatten: %w", err)
+	}
+
+	out := make(map[string]any)
+	for k, v := range obj {
+		flattenValue(k, v, sep, out)
+	}
+	return out, nil
+}
+
+// flattenValue 將 v 以 prefix 為路徑寫入 out。
+func flattenValue(prefix string, v any, sep string, out map[string]any) {
+	switch val := v.(type) {
+	case map[string]any:
+		if len(val) == 0 {
+			out[prefix] = val
+			return
+		}
+		for k, child := range val {
+			flattenValue(prefix+sep+k, child, sep, out)
+		}
+	case []any:
+		if len(val) == 0 {
+			out[prefix] = val
+			return
+		}
+		for i, child := range val {
+			flattenValue(prefix+sep+strconv.Itoa(i), child, sep, out)
+		}
+	default:
+		out[prefix] = val
+	}
+}
+
+// UnflattenJSON 為 FlattenJSON 的反向操作，依 sep 將 key 還原為巢狀 JSON 物件（sep 為空時使用 "."）。
+// 子 key 恰為 0..n-1 連續整數的節點還原為陣列，其餘還原為物件。
+// key 彼此衝突（例如同時有 "a" 與 "a.b"）時回傳 ErrFlattenKeyConflict。
+func UnflattenJSON(m map[string]any, sep string) ([]byte, error) {
+	if sep == "" {
+		sep = DefaultFlattenSeparator
+	}
+
+	// 依序處理 key，衝突時的錯誤訊息才會穩定
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	root := make(map[string]any)
+	for _, key := range keys {
+		parts := strings.Split(key, sep)
+		node := root
+		for _, p := range parts[:len(parts)-1] {
+			switch child := node[p].(type) {
+			case nil:
+				next := make(map[string]any)
+				node[p] = next
+				node = next
+			case map[string]any:
+				node = child
+			default:
+				return nil, fmt.Errorf("%w: %q", ErrFlattenKeyConflict, key)
+			}
+		}
+		leaf := parts[len(parts)-1]
+		if _, exists := node[leaf]; exists {
+			return nil, fmt.Errorf("%w: %q", ErrFlattenKeyConflict, key)
+		}
+		node[leaf] = m[key]
+	}
+
+	for k, v := range root {
+		root[k] = restoreArrays(v)
+	}
+	return json.Marshal(root)
+}
+
+// restoreArrays 遞迴將子 key 為 0..n-1 連續整數的物件轉回陣列。
+func restoreArrays(v any) any {
+	obj, ok := v.(map[string]any)
+	if !ok || len(obj) == 0 {
+		return v
+	}
+	for k, child := range obj {
+		obj[k] = restoreArrays(child)
+	}
+
+	arr := make([]any, len(obj))
+	for k, child := range obj {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(obj) || strconv.Itoa(i) != k {
+			return obj
+		}
+		arr[i] = child
+	}
+	return arr
+}
@@ -0,0 +1,130 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFlattenJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want map[string]any
+	}{
+		{"three_levels", `{"a":{"b":{"c":1}}}`, map[string]any{"a.b.c": json.Number("1")}},
+		{"array", `{"a":[10,20]}`, map[string]any{"a.0": json.Number("10"), "a.1": json.Number("20")}},
+		{"array_of_objects", `{"users":[{"name":"amy","tags":["x"]},{"name":"bob"}]}`, map[string]any{
+			"users.0.name":   "amy",
+			"users.0.tags.0": "x",
+			"users.1.name":   "bob",
+		}},
+		{"mixed_types", `{"s":"str","n":1.5,"b":true,"z":null,"o":{"i":-2}}`, map[string]any{
+			"s": "str", "n": json.Number("1.5"), "b": true, "z": nil, "o.i": json.Number("-2"),
+		}},
+		{"empty_containers", `{"o":{},"a":[]}`, map[string]any{"o": map[string]any{}, "a": []any{}}},
+		{"empty", `{}`, map[string]any{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FlattenJSON([]byte(tt.in))
+			if err != nil {
+				t.Fatalf("FlattenJSON() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlattenJSON() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenJSONWithSeparator(t *testing.T) {
+	in := `{"a":{"b":[{"c":1}]}}`
+	for _, sep := range []string{"/", "_"} {
+		got, err := FlattenJSONWithSeparator([]byte(in), sep)
+		if err != nil {
+			t.Fatalf("FlattenJSONWithSeparator(%q) error: %v", sep, err)
+		}
+		key := "a" + sep + "b" + sep + "0" + sep + "c"
+		if len(got) != 1 || got[key] != json.Number("1") {
+			t.Errorf("FlattenJSONWithSeparator(%q) = %v, want key %q", sep, got, key)
+		}
+	}
+
+	// 空分隔符使用預設的 .
+	got, _ := FlattenJSONWithSeparator([]byte(in), "")
+	if _, ok := got["a.b.0.c"]; !ok {
+		t.Errorf("FlattenJSONWithSeparator(\"\") = %v, want key a.b.0.c", got)
+	}
+}
+
+func TestFlattenJSON_Errors(t *testing.T) {
+	if _, err := FlattenJSON([]byte(`{"a":`)); err == nil {
+		t.Error("FlattenJSON() expected error for malformed JSON")
+	}
+	if _, err := FlattenJSON([]byte(`[1,2]`)); !errors.Is(err, ErrNotObject) {
+		t.Errorf("FlattenJSON() error = %v, want ErrNotObject", err)
+	}
+}
+
+func TestUnflattenJSON_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		sep  string
+	}{
+		{"three_levels", `{"a":{"b":{"c":1}}}`, "."},
+		{"array_of_objects", `{"users":[{"name":"amy","tags":["x","y"]},{"name":"bob"}]}`, "."},
+		{"mixed_types", `{"b":true,"n":1.5,"o":{"i":-2},"s":"str","z":null}`, "."},
+		{"empty_containers", `{"a":[],"o":{}}`, "."},
+		{"numeric_object_keys", `{"m":{"1":"x","2":"y"}}`, "."},
+		{"big_number", `{"id":9007199254740993}`, "."},
+		{"slash_separator", `{"a":{"b":[{"c":1},{"c":2}]}}`, "/"},
+		{"ten_elements", `{"a":[0,1,2,3,4,5,6,7,8,9,10]}`, "_"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flat, err := FlattenJSONWithSeparator([]byte(tt.in), tt.sep)
+			if err != nil {
+				t.Fatalf("FlattenJSONWithSeparator() error: %v", err)
+			}
+			got, err := UnflattenJSON(flat, tt.sep)
+			if err != nil {
+				t.Fatalf("UnflattenJSON() error: %v", err)
+			}
+			if string(got) != tt.in {
+				t.Errorf("round trip = %s, want %s", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestUnflattenJSON(t *testing.T) {
+	got, err := UnflattenJSON(map[string]any{"a.b": 1, "a.c.0": "x", "d": "y"}, "")
+	if err != nil {
+		t.Fatalf("UnflattenJSON() error: %v", err)
+	}
+	if want := `{"a":{"b":1,"c":["x"]},"d":"y"}`; string(got) != want {
+		t.Errorf("UnflattenJSON() = %s, want %s", got, want)
+	}
+
+	// 索引不連續時還原為物件
+	got, _ = UnflattenJSON(map[string]any{"a.0": 1, "a.2": 2}, ".")
+	if want := `{"a":{"0":1,"2":2}}`; string(got) != want {
+		t.Errorf("UnflattenJSON() = %s, want %s", got, want)
+	}
+}
+
+func TestUnflattenJSON_Conflict(t *testing.T) {
+	for _, m := range []map[string]any{
+		{"a": 1, "a.b": 2},
+		{"a.b": 1, "a.b.c": 2},
+	} {
+		if _, err := UnflattenJSON(m, "."); !errors.Is(err, ErrFlattenKeyConflict) {
+			t.Errorf("UnflattenJSON(%v) error = %v, want ErrFlattenKeyConflict", m, err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// JSONToForm 將 JSON 物件轉為 form 編碼用的 url.Values（OAuth token 請求、舊式 form API），
+// 只攤平最上層：
+//   - 字串直接作為值
+//   - 數字以 strconv 格式化（整數不帶小數點，1.50 → "1.5"）
+//   - 布林值為 "true" / "false"
+//   - null 為 ""
+//   - 巢狀物件轉為 JSON 字串（key 依字母排序）
+//   - 陣列以重複的 key 表示（a=1&a=2），元素的轉換規則同上；空陣列不產生任何值
+//
+// 最上層必須是物件，否則回傳 ErrNotObject。
+//
+//	form, err := jsonx.JSONToForm([]byte(`{"grant_type":"password","scope":["read","write"]}`))
+//	body := form.Encode() // "grant_type=password&scope=read&scope=write"
+func JSONToForm(data []byte) (url.Values, error) {
+	obj, err := decodeObject(data)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: json to form: %w", err)
+	}
+
+	form := make(url.Values, len(obj))
+	for k, v := range obj {
+		if arr, ok := v.([]any); ok {
+			for _, elem := range arr {
+				s, err := formValue(elem)
+				if err != nil {
+					return nil, fmt.Errorf("jsonx: json to form: %q: %w", k, err)
+				}
+				form.Add(k, s)
+			}
+			continue
+		}
+
+		s, err := formValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("jsonx: json to form: %q: %w", k, err)
+		}
+		form.Set(k, s)
+	}
+	return form, nil
+}
+
+// formValue 將單一 JSON 值（由 decodeValue 解碼）轉為 form 值。
+func formValue(v any) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return x, nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case json.Number:
+		if n, err := x.Int64(); err == nil {
+			return strconv.FormatInt(n, 10), nil
+		}
+		f, err := x.Float64()
+		if err != nil {
+			// 超出 float64 範圍時保留原始字面值
+			return x.String(), nil
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	default:
+		b, err := json.Marshal(x)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
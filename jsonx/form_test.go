@@ -0,0 +1,109 @@
+package jsonx
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestJSONToForm(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want url.Values
+	}{
+		{
+			name: "primitives",
+			in:   `{"s":"hello world","i":42,"neg":-7,"f":1.50,"exp":1e3,"t":true,"b":false}`,
+			want: url.Values{
+				"s":   {"hello world"},
+				"i":   {"42"},
+				"neg": {"-7"},
+				"f":   {"1.5"},
+				"exp": {"1000"},
+				"t":   {"true"},
+				"b":   {"false"},
+			},
+		},
+		{
+			name: "null",
+			in:   `{"state":null}`,
+			want: url.Values{"state": {""}},
+		},
+		{
+			name: "nested_object_stringified",
+			in:   `{"meta":{"z":1,"a":{"b":"c"}}}`,
+			want: url.Values{"meta": {`{"a":{"b":"c"},"z":1}`}},
+		},
+		{
+			name: "array_repeated_keys",
+			in:   `{"scope":["read","write"],"ids":[1,2.5],"mixed":[null,true,{"k":"v"},[1]]}`,
+			want: url.Values{
+				"scope": {"read", "write"},
+				"ids":   {"1", "2.5"},
+				"mixed": {"", "true", `{"k":"v"}`, "[1]"},
+			},
+		},
+		{
+			name: "empty_array",
+			in:   `{"tags":[]}`,
+			want: url.Values{},
+		},
+		{
+			name: "big_integer_keeps_precision",
+			in:   `{"id":9007199254740993}`,
+			want: url.Values{"id": {"9007199254740993"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := JSONToForm([]byte(tt.in))
+			if err != nil {
+				t.Fatalf("JSONToForm() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JSONToForm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONToForm_Encode(t *testing.T) {
+	got, err := JSONToForm([]byte(`{"grant_type":"password","scope":["read","write"]}`))
+	if err != nil {
+		t.Fatalf("JSONToForm() error: %v", err)
+	}
+	want := "grant_type=password&scope=read&scope=write"
+	if s := got.Encode(); s != want {
+		t.Errorf("Encode() = %q, want %q", s, want)
+	}
+}
+
+func TestJSONToForm_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"syntax_error", `{"a":`},
+		{"trailing_data", `{"a":1} {}`},
+		{"empty", ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := JSONToForm([]byte(tt.in)); err == nil {
+				t.Errorf("JSONToForm(%q) error = nil, want error", tt.in)
+			}
+		})
+	}
+}
+
+func TestJSONToForm_NotObject(t *testing.T) {
+	for _, in := range []string{`[1,2]`, `"s"`, `null`} {
+		if _, err := JSONToForm([]byte(in)); !errors.Is(err, ErrNotObject) {
+			t.Errorf("JSONToForm(%s) error = %v, want ErrNotObject", in, err)
+		}
+	}
+}
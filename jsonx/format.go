@@ -0,0 +1,28 @@
+package jsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// PrettyPrint 以 indent 縮排格式化已序列化的 JSON（使用 json.Indent），
+// 不經過 Go 型別的 round-trip，因此 key 順序與數字字面值皆維持原樣。
+//
+//	out, err := jsonx.PrettyPrint(body, "  ")
+func PrettyPrint(data []byte, indent string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", indent); err != nil {
+		return nil, fmt.Errorf("jsonx: pretty print: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// MinifyJSON 移除 JSON 中不影響語意的空白（使用 json.Compact），字串內的空白會保留。
+func MinifyJSON(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return nil, fmt.Errorf("jsonx: minify: %w", err)
+	}
+	return buf.Bytes(), nil
+}
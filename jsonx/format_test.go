@@ -0,0 +1,72 @@
+package jsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestPrettyPrint(t *testing.T) {
+	in := `{"b":1,"a":[1,2],"s":"x  y"}`
+	want := "{\n  \"b\": 1,\n  \"a\": [\n    1,\n    2\n  ],\n  \"s\": \"x  y\"\n}"
+
+	got, err := PrettyPrint([]byte(in), "  ")
+	if err != nil {
+		t.Fatalf("PrettyPrint() error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("PrettyPrint() = %q, want %q", got, want)
+	}
+}
+
+func TestPrettyPrintMinify_RoundTrip(t *testing.T) {
+	inputs := []string{
+		`{"a":1,"b":{"c":[1,2,{"d":null}]}}`,
+		"{ \"text\" : \"hello   world\\n\\t tab\" ,\n \"n\": 1.50 }",
+		`[ 1, "two words", true, {"k": " padded "} ]`,
+		`"just a string"`,
+	}
+
+	for _, in := range inputs {
+		var want bytes.Buffer
+		if err := json.Compact(&want, []byte(in)); err != nil {
+			t.Fatalf("json.Compact(%q) error: %v", in, err)
+		}
+
+		pretty, err := PrettyPrint([]byte(in), "\t")
+		if err != nil {
+			t.Fatalf("PrettyPrint(%q) error: %v", in, err)
+		}
+		got, err := MinifyJSON(pretty)
+		if err != nil {
+			t.Fatalf("MinifyJSON() error: %v", err)
+		}
+		if !bytes.Equal(got, want.Bytes()) {
+			t.Errorf("MinifyJSON(PrettyPrint(%q)) = %s, want %s", in, got, want.Bytes())
+		}
+	}
+}
+
+func TestMinifyJSON(t *testing.T) {
+	in := "{\n  \"msg\": \"keep  these   spaces\",\n  \"list\": [ 1 , 2 ]\n}"
+	want := `{"msg":"keep  these   spaces","list":[1,2]}`
+
+	got, err := MinifyJSON([]byte(in))
+	if err != nil {
+		t.Fatalf("MinifyJSON() error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("MinifyJSON() = %s, want %s", got, want)
+	}
+}
+
+func TestPrettyPrintMinify_Invalid(t *testing.T) {
+	for _, in := range []string{`{"a":`, `{a:1}`, ``} {
+		if _, err := PrettyPrint([]byte(in), "  "); err == nil {
+			t.Errorf("PrettyPrint(%q) expected error", in)
+		}
+		if _, err := MinifyJSON([]byte(in)); err == nil {
+			t.Errorf("MinifyJSON(%q) expected error", in)
+		}
+	}
+}
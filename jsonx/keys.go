@@ -0,0 +1,87 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/vincent119/commons/stringx"
+)
+
+// NormalizeKeys 支援的 key 命名風格。
+const (
+	KeyStyleSnake  = "snake"  // user_name
+	KeyStyleCamel  = "camel"  // userName
+	KeyStylePascal = "pascal" // UserName
+)
+
+var (
+	// ErrUnknownKeyStyle 表示 NormalizeKeys 的 style 不是支援的命名風格。
+	ErrUnknownKeyStyle = errors.New("jsonx: unknown key style")
+	// ErrNormalizeKeyConflict 表示同一物件中有多個 key 轉換後相同（例如 "userName" 與 "user_name"）。
+	ErrNormalizeKeyConflict = errors.New("jsonx: conflicting keys after normalization")
+)
+
+// NormalizeKeys 將 JSON 中所有物件的 key 轉為 style 指定的命名風格（"snake"、"camel"、"pascal"），
+// 用於串接命名慣例不同的系統。會遞迴處理巢狀物件與陣列中的物件，值本身不變；
+// 轉換使用 stringx 的 ToSnake、ToCamel、ToPascal，已是目標風格的 key 不會改變。
+//
+// 數字以原始字面值保留，輸出的 key 依字母排序。
+// style 不支援時回傳 ErrUnknownKeyStyle；轉換後 key 重複時回傳 ErrNormalizeKeyConflict。
+//
+//	out, err := jsonx.NormalizeKeys([]byte(`{"userName":"amy","orderItems":[{"itemID":1}]}`), jsonx.KeyStyleSnake)
+//	// {"order_items":[{"item_id":1}],"user_name":"amy"}
+func NormalizeKeys(data []byte, style string) ([]byte, error) {
+	var convert func(string) string
+	switch style {
+	case KeyStyleSnake:
+		convert = stringx.ToSnake
+	case KeyStyleCamel:
+		convert = stringx.ToCamel
+	case KeyStylePascal:
+		convert = stringx.ToPascal
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKeyStyle, style)
+	}
+
+	v, err := decodeValue(data)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: normalize keys: %w", err)
+	}
+	v, err = normalizeKeys(v, convert, "")
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// normalizeKeys 遞迴轉換 v 中所有物件的 key，path 用於錯誤訊息。
+func normalizeKeys(v any, convert func(string) string, path string) (any, error) {
+	switch x := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(x))
+		for k, child := range x {
+			nk := convert(k)
+			if _, exists := out[nk]; exists {
+				return nil, fmt.Errorf("%w: %q at %q", ErrNormalizeKeyConflict, nk, path)
+			}
+			child, err := normalizeKeys(child, convert, joinPath(path, nk))
+			if err != nil {
+				return nil, err
+			}
+			out[nk] = child
+		}
+		return out, nil
+	case []any:
+		for i, elem := range x {
+			elem, err := normalizeKeys(elem, convert, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			x[i] = elem
+		}
+		return x, nil
+	default:
+		return v, nil
+	}
+}
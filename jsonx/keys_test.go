@@ -0,0 +1,112 @@
+package jsonx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		style string
+		want  string
+	}{
+		{
+			name:  "nested_snake",
+			in:    `{"userName":"amy","Profile":{"homeAddress":{"zipCode":"100"}}}`,
+			style: KeyStyleSnake,
+			want:  `{"profile":{"home_address":{"zip_code":"100"}},"user_name":"amy"}`,
+		},
+		{
+			name:  "nested_camel",
+			in:    `{"user_name":"amy","profile":{"home_address":{"zip_code":"100"}}}`,
+			style: KeyStyleCamel,
+			want:  `{"profile":{"homeAddress":{"zipCode":"100"}},"userName":"amy"}`,
+		},
+		{
+			name:  "nested_pascal",
+			in:    `{"user_name":"amy","profile":{"home-address":1}}`,
+			style: KeyStylePascal,
+			want:  `{"Profile":{"HomeAddress":1},"UserName":"amy"}`,
+		},
+		{
+			name:  "array_of_objects",
+			in:    `{"orderItems":[{"itemID":1,"unitPrice":9.90},{"itemID":2,"tags":[{"tagName":"x"}]}]}`,
+			style: KeyStyleSnake,
+			want:  `{"order_items":[{"item_id":1,"unit_price":9.90},{"item_id":2,"tags":[{"tag_name":"x"}]}]}`,
+		},
+		{
+			name:  "top_level_array",
+			in:    `[{"firstName":"a"},"plainString",3]`,
+			style: KeyStyleSnake,
+			want:  `[{"first_name":"a"},"plainString",3]`,
+		},
+		{
+			name:  "values_unchanged",
+			in:    `{"key":"someValue_NotAKey","n":null,"big":9007199254740993}`,
+			style: KeyStyleSnake,
+			want:  `{"big":9007199254740993,"key":"someValue_NotAKey","n":null}`,
+		},
+		{
+			name:  "scalar",
+			in:    `"userName"`,
+			style: KeyStyleSnake,
+			want:  `"userName"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeKeys([]byte(tt.in), tt.style)
+			if err != nil {
+				t.Fatalf("NormalizeKeys() error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("NormalizeKeys(%s, %q) = %s, want %s", tt.in, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeKeys_Idempotent(t *testing.T) {
+	tests := []struct {
+		style string
+		in    string
+	}{
+		{KeyStyleSnake, `{"items":[{"item_id":1}],"user_name":"amy"}`},
+		{KeyStyleCamel, `{"items":[{"itemId":1}],"userName":"amy"}`},
+		{KeyStylePascal, `{"Items":[{"ItemId":1}],"UserName":"amy"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			got, err := NormalizeKeys([]byte(tt.in), tt.style)
+			if err != nil {
+				t.Fatalf("NormalizeKeys() error: %v", err)
+			}
+			if string(got) != tt.in {
+				t.Errorf("NormalizeKeys(%s) = %s, want unchanged", tt.in, got)
+			}
+			again, err := NormalizeKeys(got, tt.style)
+			if err != nil || string(again) != string(got) {
+				t.Errorf("second NormalizeKeys() = %s, %v, want %s", again, err, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeKeys_Errors(t *testing.T) {
+	if _, err := NormalizeKeys([]byte(`{"a":1}`), "kebab"); !errors.Is(err, ErrUnknownKeyStyle) {
+		t.Errorf("unknown style error = %v, want ErrUnknownKeyStyle", err)
+	}
+	if _, err := NormalizeKeys([]byte(`{"a":1}`), ""); !errors.Is(err, ErrUnknownKeyStyle) {
+		t.Errorf("empty style error = %v, want ErrUnknownKeyStyle", err)
+	}
+	if _, err := NormalizeKeys([]byte(`{"x":{"userName":1,"user_name":2}}`), KeyStyleSnake); !errors.Is(err, ErrNormalizeKeyConflict) {
+		t.Errorf("conflict error = %v, want ErrNormalizeKeyConflict", err)
+	}
+	if _, err := NormalizeKeys([]byte(`{"a":`), KeyStyleSnake); err == nil {
+		t.Error("invalid JSON error = nil, want error")
+	}
+}
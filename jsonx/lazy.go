@@ -0,0 +1,79 @@
+package jsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// LazyJSON 延遲解析的 JSON 物件：建立時只切分最上層欄位（保留為 json.RawMessage），
+// 各欄位的值在取用時才解碼，適合欄位很多但只需要其中幾個的 payload。
+// 建立後為唯讀，可安全地併發讀取。
+//
+//	doc, err := jsonx.NewLazyJSON(body)
+//	id, err := doc.GetInt("id")
+//	raw, ok := doc.Get("metadata") // 巢狀物件，需要時再 json.Unmarshal
+type LazyJSON struct {
+	fields map[string]json.RawMessage
+}
+
+// NewLazyJSON 以 data 建立 LazyJSON，data 的最上層必須是物件，否則回傳 ErrNotObject。
+func NewLazyJSON(data []byte) (*LazyJSON, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || trimmed[0] != '{' {
+		if !json.Valid(data) {
+			return nil, errors.New("jsonx: lazy: invalid JSON")
+		}
+		return nil, ErrNotObject
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("jsonx: lazy: %w", err)
+	}
+	return &LazyJSON{fields: fields}, nil
+}
+
+// Get 回傳欄位的原始 JSON（未解碼），欄位不存在時 ok 為 false。
+// 值為 null 時回傳 json.RawMessage("null"), true。
+func (l *LazyJSON) Get(field string) (json.RawMessage, bool) {
+	raw, ok := l.fields[field]
+	return raw, ok
+}
+
+// GetString 取出字串欄位。欄位不存在時回傳包裝 ErrJSONPathNotFound 的錯誤，
+// 值不是字串（包含 null）時回傳包裝 ErrJSONTypeMismatch 的錯誤。
+func (l *LazyJSON) GetString(field string) (string, error) {
+	return lazyGet[string](l, field, "a string")
+}
+
+// GetInt 取出整數欄位，帶小數或超出 int64 範圍的數字視為型別不符；錯誤規則同 GetString。
+func (l *LazyJSON) GetInt(field string) (int64, error) {
+	return lazyGet[int64](l, field, "an integer")
+}
+
+// GetFloat 取出數字欄位；錯誤規則同 GetString。
+func (l *LazyJSON) GetFloat(field string) (float64, error) {
+	return lazyGet[float64](l, field, "a number")
+}
+
+// GetBool 取出布林欄位；錯誤規則同 GetString。
+func (l *LazyJSON) GetBool(field string) (bool, error) {
+	return lazyGet[bool](l, field, "a boolean")
+}
+
+// lazyGet 將欄位解碼為 T。json.Unmarshal 遇到 null 不會回傳錯誤，因此先行排除。
+func lazyGet[T any](l *LazyJSON, field, want string) (T, error) {
+	var v T
+	raw, ok := l.fields[field]
+	if !ok {
+		return v, fmt.Errorf("%w: %q", ErrJSONPathNotFound, field)
+	}
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return v, fmt.Errorf("%w: %q is null, not %s", ErrJSONTypeMismatch, field, want)
+	}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return v, fmt.Errorf("%w: %q is not %s", ErrJSONTypeMismatch, field, want)
+	}
+	return v, nil
+}
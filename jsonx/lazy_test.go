@@ -0,0 +1,121 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+const lazyDoc = `{
+	"name": "amy",
+	"id": 9007199254740993,
+	"score": 98.5,
+	"active": true,
+	"deleted": null,
+	"meta": {"tags": ["a", "b"], "level": 3}
+}`
+
+func newTestLazy(t *testing.T) *LazyJSON {
+	t.Helper()
+	l, err := NewLazyJSON([]byte(lazyDoc))
+	if err != nil {
+		t.Fatalf("NewLazyJSON() error: %v", err)
+	}
+	return l
+}
+
+func TestLazyJSON_Primitives(t *testing.T) {
+	l := newTestLazy(t)
+
+	if got, err := l.GetString("name"); err != nil || got != "amy" {
+		t.Errorf("GetString(name) = %q, %v, want amy", got, err)
+	}
+	if got, err := l.GetInt("id"); err != nil || got != 9007199254740993 {
+		t.Errorf("GetInt(id) = %d, %v, want 9007199254740993", got, err)
+	}
+	if got, err := l.GetFloat("score"); err != nil || got != 98.5 {
+		t.Errorf("GetFloat(score) = %v, %v, want 98.5", got, err)
+	}
+	if got, err := l.GetBool("active"); err != nil || !got {
+		t.Errorf("GetBool(active) = %v, %v, want true", got, err)
+	}
+	if raw, ok := l.Get("deleted"); !ok || string(raw) != "null" {
+		t.Errorf("Get(deleted) = %s, %v, want null, true", raw, ok)
+	}
+}
+
+func TestLazyJSON_NestedObject(t *testing.T) {
+	l := newTestLazy(t)
+
+	raw, ok := l.Get("meta")
+	if !ok {
+		t.Fatal("Get(meta) ok = false, want true")
+	}
+	var meta struct {
+		Tags  []string `json:"tags"`
+		Level int      `json:"level"`
+	}
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		t.Fatalf("json.Unmarshal(meta) error: %v", err)
+	}
+	if len(meta.Tags) != 2 || meta.Level != 3 {
+		t.Errorf("meta = %+v, want 2 tags and level 3", meta)
+	}
+}
+
+func TestLazyJSON_Missing(t *testing.T) {
+	l := newTestLazy(t)
+
+	if raw, ok := l.Get("missing"); ok || raw != nil {
+		t.Errorf("Get(missing) = %s, %v, want nil, false", raw, ok)
+	}
+	if got, err := l.GetString("missing"); !errors.Is(err, ErrJSONPathNotFound) || got != "" {
+		t.Errorf("GetString(missing) = %q, %v, want ErrJSONPathNotFound", got, err)
+	}
+	if got, err := l.GetInt("missing"); !errors.Is(err, ErrJSONPathNotFound) || got != 0 {
+		t.Errorf("GetInt(missing) = %d, %v, want ErrJSONPathNotFound", got, err)
+	}
+	if got, err := l.GetFloat("missing"); !errors.Is(err, ErrJSONPathNotFound) || got != 0 {
+		t.Errorf("GetFloat(missing) = %v, %v, want ErrJSONPathNotFound", got, err)
+	}
+	if got, err := l.GetBool("missing"); !errors.Is(err, ErrJSONPathNotFound) || got {
+		t.Errorf("GetBool(missing) = %v, %v, want ErrJSONPathNotFound", got, err)
+	}
+}
+
+func TestLazyJSON_TypeMismatch(t *testing.T) {
+	l := newTestLazy(t)
+
+	tests := []struct {
+		name string
+		get  func() error
+	}{
+		{"string_from_number", func() error { _, err := l.GetString("id"); return err }},
+		{"string_from_null", func() error { _, err := l.GetString("deleted"); return err }},
+		{"int_from_float", func() error { _, err := l.GetInt("score"); return err }},
+		{"int_from_null", func() error { _, err := l.GetInt("deleted"); return err }},
+		{"float_from_string", func() error { _, err := l.GetFloat("name"); return err }},
+		{"bool_from_object", func() error { _, err := l.GetBool("meta"); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.get(); !errors.Is(err, ErrJSONTypeMismatch) {
+				t.Errorf("error = %v, want ErrJSONTypeMismatch", err)
+			}
+		})
+	}
+}
+
+func TestNewLazyJSON_Invalid(t *testing.T) {
+	for _, in := range []string{`[1,2]`, `"s"`, `null`} {
+		if _, err := NewLazyJSON([]byte(in)); !errors.Is(err, ErrNotObject) {
+			t.Errorf("NewLazyJSON(%s) error = %v, want ErrNotObject", in, err)
+		}
+	}
+	for _, in := range []string{``, `{"a":`, `{"a":1} x`, `nope`} {
+		if _, err := NewLazyJSON([]byte(in)); err == nil || errors.Is(err, ErrNotObject) {
+			t.Errorf("NewLazyJSON(%q) error = %v, want syntax error", in, err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package jsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNotObject 表示 JSON 的最上層不是物件。
+var ErrNotObject = errors.New("jsonx: top-level JSON value is not an object")
+
+// MergeJSON 遞迴合併兩個 JSON 物件，適用於以使用者設定覆蓋預設設定：
+//   - 兩邊皆為物件的欄位會遞迴合併
+//   - 其他衝突欄位以 override 為準，override 沒有的欄位保留 base 的值
+//   - 陣列整個取代，不會串接
+//   - override 中值為 null 的欄位會刪除該 key（與 RFC 7386 JSON Merge Patch 相同），
+//     而非保留 base 的值；需要明確設定 null 時請勿使用此函式
+//
+// 兩個輸入的最上層都必須是物件，否則回傳 ErrNotObject。
+// 數字以原始字面值保留（不會轉成 float64 而失去精度），輸出的 key 依字母排序。
+func MergeJSON(base, override []byte) ([]byte, error) {
+	b, err := decodeObject(base)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: merge base: %w", err)
+	}
+	o, err := decodeObject(override)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: merge override: %w", err)
+	}
+	return json.Marshal(mergeObjects(b, o))
+}
+
+// mergeObjects 將 override 合併進 base（會修改 base）後回傳。
+func mergeObjects(base, override map[string]any) map[string]any {
+	for k, ov := range override {
+		if ov == nil {
+			delete(base, k)
+			continue
+		}
+		bObj, bOK := base[k].(map[string]any)
+		oObj, oOK := ov.(map[string]any)
+		if bOK && oOK {
+			base[k] = mergeObjects(bObj, oObj)
+			continue
+		}
+		base[k] = ov
+	}
+	return base
+}
+
+// decodeObject 將 data 解碼為 JSON 物件，數字以 json.Number 保留。
+func decodeObject(data []byte) (map[string]any, error) {
+	v, err := decodeValue(data)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, ErrNotObject
+	}
+	return obj, nil
+}
+
+// decodeValue 將 data 解碼為單一 JSON 值，數字以 json.Number 保留，不允許多餘的資料。
+func decodeValue(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+	return v, nil
+}
@@ -0,0 +1,82 @@
+package jsonx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMergeJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		override string
+		want     string
+	}{
+		{"override_wins", `{"a":1,"b":2}`, `{"b":3}`, `{"a":1,"b":3}`},
+		{"add_key", `{"a":1}`, `{"b":2}`, `{"a":1,"b":2}`},
+		{"empty_override", `{"a":1}`, `{}`, `{"a":1}`},
+		{"nested_objects",
+			`{"db":{"host":"localhost","port":5432},"debug":false}`,
+			`{"db":{"host":"prod.db"},"debug":true}`,
+			`{"db":{"host":"prod.db","port":5432},"debug":true}`},
+		{"array_replaced",
+			`{"tags":["a","b","c"],"ports":[80,443]}`,
+			`{"tags":["x"]}`,
+			`{"ports":[80,443],"tags":["x"]}`},
+		{"array_of_objects_replaced",
+			`{"servers":[{"name":"a","port":1}]}`,
+			`{"servers":[{"name":"b"}]}`,
+			`{"servers":[{"name":"b"}]}`},
+		// null 刪除 key（RFC 7386），而非保留 base 的值
+		{"null_deletes_key", `{"a":1,"b":{"c":2,"d":3}}`, `{"a":null,"b":{"c":null}}`, `{"b":{"d":3}}`},
+		{"null_on_missing_key", `{"a":1}`, `{"z":null}`, `{"a":1}`},
+		{"object_replaces_scalar", `{"a":1}`, `{"a":{"b":2}}`, `{"a":{"b":2}}`},
+		{"scalar_replaces_object", `{"a":{"b":2}}`, `{"a":"flat"}`, `{"a":"flat"}`},
+		{"deeply_nested",
+			`{"l1":{"l2":{"l3":{"l4":{"keep":1,"change":"old"},"x":true}}}}`,
+			`{"l1":{"l2":{"l3":{"l4":{"change":"new","add":[1]}}}}}`,
+			`{"l1":{"l2":{"l3":{"l4":{"add":[1],"change":"new","keep":1},"x":true}}}}`},
+		{"number_precision", `{"id":9007199254740993,"f":1.50}`, `{}`, `{"f":1.50,"id":9007199254740993}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergeJSON([]byte(tt.base), []byte(tt.override))
+			if err != nil {
+				t.Fatalf("MergeJSON() error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MergeJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		override string
+		wantErr  error
+	}{
+		{"invalid_base", `{"a":`, `{}`, nil},
+		{"invalid_override", `{}`, `{"a" 1}`, nil},
+		{"trailing_data", `{} {}`, `{}`, nil},
+		{"empty_input", ``, `{}`, nil},
+		{"array_base", `[1,2]`, `{}`, ErrNotObject},
+		{"scalar_override", `{}`, `"str"`, ErrNotObject},
+		{"null_override", `{}`, `null`, ErrNotObject},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MergeJSON([]byte(tt.base), []byte(tt.override))
+			if err == nil {
+				t.Fatal("MergeJSON() expected error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("MergeJSON() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
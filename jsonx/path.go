@@ -0,0 +1,163 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidJSONPath 表示路徑語法錯誤。
+	ErrInvalidJSONPath = errors.New("jsonx: invalid JSON path")
+	// ErrJSONPathNotFound 表示路徑不存在（key 不存在、索引超出範圍或中途遇到非容器值）。
+	ErrJSONPathNotFound = errors.New("jsonx: JSON path not found")
+	// ErrJSONTypeMismatch 表示路徑上的值型別不符（例如 GetJSONString 取到數字）。
+	ErrJSONTypeMismatch = errors.New("jsonx: JSON value type mismatch")
+)
+
+// pathSegment 為路徑的一段：物件 key 或陣列索引。
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// GetJSONValue 依路徑取出 JSON 中的值，支援 . 分隔的 key 與 [n] 陣列索引：
+// "a.b.c"、"items[0].name"、"matrix[1][2]"、"[0].id"（最上層為陣列）。
+// 回傳 json.Unmarshal 的標準型別：string、float64、bool、nil、map[string]any、[]any。
+// 路徑存在但值為 null 時回傳 nil, nil；路徑不存在時回傳包裝 ErrJSONPathNotFound 的錯誤。
+//
+//	v, err := jsonx.GetJSONValue(data, "user.addresses[0].city")
+func GetJSONValue(data []byte, path string) (any, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("jsonx: get %q: %w", path, err)
+	}
+
+	for i, seg := range segments {
+		at := formatPath(segments[:i+1])
+		if seg.isIndex {
+			arr, ok := v.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%w: %q is %s, not an array", ErrJSONPathNotFound, formatPath(segments[:i]), jsonTypeName(v))
+			}
+			if seg.index >= len(arr) {
+				return nil, fmt.Errorf("%w: %q index out of range (len %d)", ErrJSONPathNotFound, at, len(arr))
+			}
+			v = arr[seg.index]
+			continue
+		}
+
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is %s, not an object", ErrJSONPathNotFound, formatPath(segments[:i]), jsonTypeName(v))
+		}
+		if v, ok = obj[seg.key]; !ok {
+			return nil, fmt.Errorf("%w: %q", ErrJSONPathNotFound, at)
+		}
+	}
+	return v, nil
+}
+
+// GetJSONString 同 GetJSONValue，但值必須為字串，否則（包含 null）回傳包裝 ErrJSONTypeMismatch 的錯誤。
+func GetJSONString(data []byte, path string) (string, error) {
+	v, err := GetJSONValue(data, path)
+	if err != nil {
+		return "", err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %q is %s, not a string", ErrJSONTypeMismatch, path, jsonTypeName(v))
+	}
+	return s, nil
+}
+
+// parsePath 解析 "a.b[0].c" 形式的路徑。
+func parsePath(path string) ([]pathSegment, error) {
+	if path == "" {
+		return nil, fmt.Errorf("%w: empty path", ErrInvalidJSONPath)
+	}
+
+	var segments []pathSegment
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("%w: %q: missing ]", ErrInvalidJSONPath, path)
+			}
+			n, err := strconv.Atoi(path[i+1 : i+end])
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%w: %q: invalid index %q", ErrInvalidJSONPath, path, path[i+1:i+end])
+			}
+			segments = append(segments, pathSegment{index: n, isIndex: true})
+			i += end + 1
+			if i < len(path) && path[i] == '.' {
+				i++
+				if i == len(path) {
+					return nil, fmt.Errorf("%w: %q: trailing .", ErrInvalidJSONPath, path)
+				}
+			}
+		default:
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("%w: %q: empty key", ErrInvalidJSONPath, path)
+			}
+			segments = append(segments, pathSegment{key: path[i : i+end]})
+			i += end
+			if i < len(path) && path[i] == '.' {
+				i++
+				if i == len(path) {
+					return nil, fmt.Errorf("%w: %q: trailing .", ErrInvalidJSONPath, path)
+				}
+			}
+		}
+	}
+	return segments, nil
+}
+
+// formatPath 將路徑片段還原為字串，用於錯誤訊息。
+func formatPath(segments []pathSegment) string {
+	var b strings.Builder
+	for _, seg := range segments {
+		if seg.isIndex {
+			fmt.Fprintf(&b, "[%d]", seg.index)
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(seg.key)
+	}
+	return b.String()
+}
+
+// jsonTypeName 回傳 JSON 值的型別名稱，用於錯誤訊息。
+func jsonTypeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	case []any:
+		return "an array"
+	case map[string]any:
+		return "an object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
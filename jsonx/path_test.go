@@ -0,0 +1,114 @@
+package jsonx
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const pathFixture = `{
+	"user": {
+		"name": "amy",
+		"age": 30,
+		"active": true,
+		"nickname": null,
+		"profile": {"address": {"city": "Taipei"}},
+		"tags": ["a", "b"],
+		"orders": [{"id": 1, "items": [{"sku": "x1"}]}, {"id": 2}]
+	},
+	"matrix": [[1, 2], [3, 4]]
+}`
+
+func TestGetJSONValue(t *testing.T) {
+	tests := []struct {
+		path string
+		want any
+	}{
+		{"user.name", "amy"},
+		{"user.age", float64(30)},
+		{"user.active", true},
+		{"user.nickname", nil},
+		{"user.profile.address.city", "Taipei"},
+		{"user.tags[1]", "b"},
+		{"user.orders[0].items[0].sku", "x1"},
+		{"user.orders[1].id", float64(2)},
+		{"matrix[1][0]", float64(3)},
+		{"user.tags", []any{"a", "b"}},
+		{"user.profile.address", map[string]any{"city": "Taipei"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := GetJSONValue([]byte(pathFixture), tt.path)
+			if err != nil {
+				t.Fatalf("GetJSONValue(%q) error: %v", tt.path, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetJSONValue(%q) = %#v, want %#v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetJSONValue_TopLevelArray(t *testing.T) {
+	got, err := GetJSONValue([]byte(`[{"id":"a"},{"id":"b"}]`), "[1].id")
+	if err != nil || got != "b" {
+		t.Errorf("GetJSONValue() = (%v, %v), want (b, nil)", got, err)
+	}
+}
+
+func TestGetJSONValue_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		path    string
+		wantErr error
+		wantMsg string
+	}{
+		{"missing_key", pathFixture, "user.email", ErrJSONPathNotFound, `"user.email"`},
+		{"missing_nested", pathFixture, "user.profile.phone.number", ErrJSONPathNotFound, `"user.profile.phone"`},
+		{"index_out_of_range", pathFixture, "user.tags[5]", ErrJSONPathNotFound, "index out of range"},
+		{"index_on_object", pathFixture, "user[0]", ErrJSONPathNotFound, "not an array"},
+		{"key_on_scalar", pathFixture, "user.name.first", ErrJSONPathNotFound, `"user.name" is a string`},
+		{"key_on_null", pathFixture, "user.nickname.x", ErrJSONPathNotFound, "is null"},
+		{"empty_path", pathFixture, "", ErrInvalidJSONPath, "empty path"},
+		{"unclosed_bracket", pathFixture, "user.tags[0", ErrInvalidJSONPath, "missing ]"},
+		{"bad_index", pathFixture, "user.tags[x]", ErrInvalidJSONPath, "invalid index"},
+		{"negative_index", pathFixture, "user.tags[-1]", ErrInvalidJSONPath, "invalid index"},
+		{"empty_key", pathFixture, "user..name", ErrInvalidJSONPath, "empty key"},
+		{"trailing_dot", pathFixture, "user.", ErrInvalidJSONPath, "trailing ."},
+		{"invalid_json", `{"a":`, "a", nil, "jsonx: get"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GetJSONValue([]byte(tt.data), tt.path)
+			if err == nil {
+				t.Fatal("GetJSONValue() expected error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetJSONValue() error = %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("GetJSONValue() error = %q, want containing %q", err, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestGetJSONString(t *testing.T) {
+	got, err := GetJSONString([]byte(pathFixture), "user.profile.address.city")
+	if err != nil || got != "Taipei" {
+		t.Fatalf("GetJSONString() = (%q, %v), want (Taipei, nil)", got, err)
+	}
+
+	for _, path := range []string{"user.age", "user.nickname", "user.tags"} {
+		if _, err := GetJSONString([]byte(pathFixture), path); !errors.Is(err, ErrJSONTypeMismatch) {
+			t.Errorf("GetJSONString(%q) error = %v, want ErrJSONTypeMismatch", path, err)
+		}
+	}
+	if _, err := GetJSONString([]byte(pathFixture), "user.email"); !errors.Is(err, ErrJSONPathNotFound) {
+		t.Errorf("GetJSONString() error = %v, want ErrJSONPathNotFound", err)
+	}
+}
@@ -0,0 +1,74 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DefaultRedactMask 為 RedactJSON 在 mask 為空時使用的遮罩。
+const DefaultRedactMask = "***"
+
+// RedactJSON 將任意深度中名稱符合 fields 的欄位值替換為 mask（區分大小寫），
+// 用於記錄含密碼、token 的 JSON 前去除敏感資訊。mask 為空時使用 DefaultRedactMask。
+//   - 物件與陣列型別的值整個替換為 mask
+//   - 值為 null 的欄位維持 null（沒有需要遮蔽的內容）
+//   - 不存在的欄位不影響輸出
+//
+// 數字以原始字面值保留，輸出的 key 依字母排序。
+//
+//	out, err := jsonx.RedactJSON(data, []string{"password", "token"}, "***")
+func RedactJSON(data []byte, fields []string, mask string) ([]byte, error) {
+	set := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		set[f] = struct{}{}
+	}
+	return redactJSON(data, mask, func(key string) bool {
+		_, ok := set[key]
+		return ok
+	})
+}
+
+// RedactJSONFold 同 RedactJSON，但欄位名稱比對不區分大小寫（"Password"、"PASSWORD" 皆符合 "password"）。
+func RedactJSONFold(data []byte, fields []string, mask string) ([]byte, error) {
+	set := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		set[strings.ToLower(f)] = struct{}{}
+	}
+	return redactJSON(data, mask, func(key string) bool {
+		_, ok := set[strings.ToLower(key)]
+		return ok
+	})
+}
+
+func redactJSON(data []byte, mask string, match func(key string) bool) ([]byte, error) {
+	if mask == "" {
+		mask = DefaultRedactMask
+	}
+	v, err := decodeValue(data)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: redact: %w", err)
+	}
+	return json.Marshal(redactValue(v, mask, match))
+}
+
+// redactValue 遞迴遮蔽 v 中符合 match 的欄位（會修改 v）。
+func redactValue(v any, mask string, match func(string) bool) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, child := range val {
+			if match(k) {
+				if child != nil {
+					val[k] = mask
+				}
+				continue
+			}
+			val[k] = redactValue(child, mask, match)
+		}
+	case []any:
+		for i, child := range val {
+			val[i] = redactValue(child, mask, match)
+		}
+	}
+	return v
+}
@@ -0,0 +1,60 @@
+package jsonx
+
+import "testing"
+
+func TestRedactJSON(t *testing.T) {
+	fields := []string{"password", "token"}
+
+	tests := []struct {
+		name string
+		in   string
+		mask string
+		want string
+	}{
+		{"top_level", `{"user":"amy","password":"secret"}`, "***", `{"password":"***","user":"amy"}`},
+		{"nested", `{"auth":{"credentials":{"password":"p","hint":"h"}}}`, "***", `{"auth":{"credentials":{"hint":"h","password":"***"}}}`},
+		{"array_of_objects", `{"users":[{"name":"a","token":"t1"},{"name":"b","token":"t2"}]}`, "[REDACTED]",
+			`{"users":[{"name":"a","token":"[REDACTED]"},{"name":"b","token":"[REDACTED]"}]}`},
+		{"top_level_array", `[{"token":"t"},{"id":1}]`, "***", `[{"token":"***"},{"id":1}]`},
+		{"null_is_noop", `{"password":null,"user":"amy"}`, "***", `{"password":null,"user":"amy"}`},
+		{"missing_field_noop", `{"user":"amy","age":30}`, "***", `{"age":30,"user":"amy"}`},
+		{"non_string_values", `{"token":12345,"password":{"old":"a","new":"b"}}`, "***", `{"password":"***","token":"***"}`},
+		{"case_sensitive", `{"Password":"p"}`, "***", `{"Password":"p"}`},
+		{"default_mask", `{"token":"t"}`, "", `{"token":"***"}`},
+		{"number_precision", `{"id":9007199254740993,"token":"t"}`, "***", `{"id":9007199254740993,"token":"***"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RedactJSON([]byte(tt.in), fields, tt.mask)
+			if err != nil {
+				t.Fatalf("RedactJSON() error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("RedactJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactJSONFold(t *testing.T) {
+	in := `{"Password":"p","API_TOKEN":"t","nested":[{"PASSWORD":"x","api_token":"y","user":"amy"}]}`
+	want := `{"API_TOKEN":"***","Password":"***","nested":[{"PASSWORD":"***","api_token":"***","user":"amy"}]}`
+
+	got, err := RedactJSONFold([]byte(in), []string{"password", "Api_Token"}, "***")
+	if err != nil {
+		t.Fatalf("RedactJSONFold() error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("RedactJSONFold() = %s, want %s", got, want)
+	}
+}
+
+func TestRedactJSON_InvalidJSON(t *testing.T) {
+	if _, err := RedactJSON([]byte(`{"password":`), []string{"password"}, "***"); err == nil {
+		t.Error("RedactJSON() expected error for invalid JSON")
+	}
+	if _, err := RedactJSONFold([]byte(`nope`), []string{"password"}, "***"); err == nil {
+		t.Error("RedactJSONFold() expected error for invalid JSON")
+	}
+}
@@ -11,4 +11,25 @@
 //   - 跨平台路徑處理
 //   - URL 路徑建構
 //   - 檔案系統路徑統一
+//
+// # 結尾斜線
+//
+// 組合 URL 或 S3 前綴時確保恰好一個（或沒有）結尾斜線，重複呼叫結果不變：
+//
+//	prefix := pathx.EnsureTrailingSlash("uploads//") // "uploads/"
+//	base := pathx.EnsureNoTrailingSlash(endpoint)    // "https://example.com"
+//
+// # 副檔名
+//
+// 替換或移除副檔名（例如轉檔流程）：
+//
+//	out := pathx.ReplaceExtension("media/video.mp4", "webm") // "media/video.webm"
+//	name := pathx.ReplaceExtension("photo.jpg", "")          // "photo"
+//
+// # 防止目錄穿越
+//
+// 將不可信的路徑（上傳檔名、URL 參數）安全地接在 base 目錄之下，逃出 base 時回傳錯誤：
+//
+//	p, err := pathx.SafeJoin("/srv/uploads", userPath)
+//	if errors.Is(err, pathx.ErrPathTraversal) { ... } // ../../etc/passwd、/etc/passwd、..\..\x
 package pathx
@@ -0,0 +1,23 @@
+package pathx
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// ReplaceExtension 將 path 的副檔名替換為 newExt（"webm" 與 ".webm" 皆可），newExt 為空時移除副檔名：
+//
+//	pathx.ReplaceExtension("media/video.mp4", "webm") // "media/video.webm"
+//	pathx.ReplaceExtension("archive.tar.gz", ".zst")  // "archive.tar.zst"
+//	pathx.ReplaceExtension("README", "md")            // "README.md"
+//
+// 副檔名的判斷同 filepath.Ext（最後一個 . 之後，僅限檔名部分），因此 ".gitignore" 整個視為副檔名：
+// ReplaceExtension(".gitignore", "txt") 為 ".txt"。
+func ReplaceExtension(path, newExt string) string {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	newExt = strings.TrimPrefix(newExt, ".")
+	if newExt == "" {
+		return base
+	}
+	return base + "." + newExt
+}
@@ -0,0 +1,38 @@
+package pathx
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestReplaceExtension(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		newExt string
+		want   string
+	}{
+		{"simple", "video.mp4", "webm", "video.webm"},
+		{"leading_dot", "video.mp4", ".webm", "video.webm"},
+		{"no_extension", "README", "md", "README.md"},
+		{"dotfile", ".gitignore", "txt", ".txt"},
+		{"dotfile_strip", ".gitignore", "", ""},
+		{"multiple_dots", "archive.tar.gz", "zst", "archive.tar.zst"},
+		{"version_dots", "app.v1.2.js", "mjs", "app.v1.2.mjs"},
+		{"empty_ext_strips", "photo.jpg", "", "photo"},
+		{"dot_only_ext_strips", "photo.jpg", ".", "photo"},
+		{"directories", "media/2025/clip.mov", "mp4", "media/2025/clip.mp4"},
+		{"dot_in_directory", "v1.2/clip", "mp4", "v1.2/clip.mp4"},
+		{"trailing_dot", "file.", "txt", "file.txt"},
+		{"empty_path", "", "txt", ".txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, want := filepath.FromSlash(tt.path), filepath.FromSlash(tt.want)
+			if got := ReplaceExtension(path, tt.newExt); got != want {
+				t.Errorf("ReplaceExtension(%q, %q) = %q, want %q", path, tt.newExt, got, want)
+			}
+		})
+	}
+}
@@ -7,3 +7,22 @@ func NormalizePathSeparator(path string) string {
 	// 將 Windows 風格的反斜線替換為正斜線
 	return strings.ReplaceAll(path, "\\", "/")
 }
+
+// EnsureTrailingSlash 回傳以恰好一個 / 結尾的 path（多個結尾 / 會合併為一個），適用於 URL 與 S3 前綴：
+//
+//	pathx.EnsureTrailingSlash("uploads")   // "uploads/"
+//	pathx.EnsureTrailingSlash("uploads//") // "uploads/"
+//
+// 空字串回傳 "/"；僅處理正斜線，反斜線請先以 NormalizePathSeparator 轉換。
+func EnsureTrailingSlash(path string) string {
+	return strings.TrimRight(path, "/") + "/"
+}
+
+// EnsureNoTrailingSlash 移除 path 結尾所有的 /：
+//
+//	pathx.EnsureNoTrailingSlash("uploads//") // "uploads"
+//
+// 注意 "/" 會變成空字串；僅處理正斜線。
+func EnsureNoTrailingSlash(path string) string {
+	return strings.TrimRight(path, "/")
+}
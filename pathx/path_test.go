@@ -23,3 +23,42 @@ func TestNormalizePathSeparator(t *testing.T) {
 		})
 	}
 }
+
+func TestTrailingSlash(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		ensure string
+		strip  string
+	}{
+		{"empty", "", "/", ""},
+		{"root", "/", "/", ""},
+		{"multiple_root", "///", "/", ""},
+		{"none", "uploads", "uploads/", "uploads"},
+		{"one", "uploads/", "uploads/", "uploads"},
+		{"multiple", "uploads///", "uploads/", "uploads"},
+		{"nested", "a/b//c/", "a/b//c/", "a/b//c"},
+		{"url", "https://example.com/", "https://example.com/", "https://example.com"},
+		{"backslash", "dir\\", "dir\\/", "dir\\"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EnsureTrailingSlash(tt.path)
+			if got != tt.ensure {
+				t.Errorf("EnsureTrailingSlash(%q) = %q, want %q", tt.path, got, tt.ensure)
+			}
+			if again := EnsureTrailingSlash(got); again != got {
+				t.Errorf("EnsureTrailingSlash not idempotent: %q -> %q", got, again)
+			}
+
+			got = EnsureNoTrailingSlash(tt.path)
+			if got != tt.strip {
+				t.Errorf("EnsureNoTrailingSlash(%q) = %q, want %q", tt.path, got, tt.strip)
+			}
+			if again := EnsureNoTrailingSlash(got); again != got {
+				t.Errorf("EnsureNoTrailingSlash not idempotent: %q -> %q", got, again)
+			}
+		})
+	}
+}
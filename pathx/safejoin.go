@@ -0,0 +1,37 @@
+package pathx
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// ErrPathTraversal 表示路徑會逃出 base 目錄（例如 ../../etc/passwd 或絕對路徑）。
+var ErrPathTraversal = errors.New("pathx: path escapes base directory")
+
+// SafeJoin 將不可信的相對路徑 path（例如上傳檔名、URL 參數）接在 base 之下，
+// 並確認結果仍位於 base 目錄內，防止 directory traversal：
+//
+//	p, err := pathx.SafeJoin("/srv/uploads", "2025/photo.jpg")   // "/srv/uploads/2025/photo.jpg"
+//	_, err = pathx.SafeJoin("/srv/uploads", "../../etc/passwd") // errors.Is(err, pathx.ErrPathTraversal)
+//
+// path 中的 \ 在所有平台都視為分隔符（同 NormalizePathSeparator），使 Windows 風格的 ..\ 也會被拒絕；
+// 絕對路徑（含 Windows 的磁碟機代號）一律拒絕。path 解析後等於 base 本身時允許。
+// 僅做字串層級的檢查，不會解析 symlink。
+func SafeJoin(base, path string) (string, error) {
+	rel := filepath.FromSlash(NormalizePathSeparator(path))
+	if filepath.IsAbs(rel) || filepath.VolumeName(rel) != "" || strings.HasPrefix(rel, string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %q is an absolute path", ErrPathTraversal, path)
+	}
+
+	cleanBase := filepath.Clean(base)
+	joined := filepath.Join(cleanBase, rel)
+
+	// 以 Rel 判斷而非字串前綴，使 "." 等相對 base（Join 後不含 "./" 前綴）也能正確比對
+	r, err := filepath.Rel(cleanBase, joined)
+	if err != nil || r == ".." || strings.HasPrefix(r, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %q resolves outside %q", ErrPathTraversal, path, cleanBase)
+	}
+	return joined, nil
+}
@@ -0,0 +1,59 @@
+package pathx
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestSafeJoin(t *testing.T) {
+	base := filepath.FromSlash("/srv/uploads")
+
+	tests := []struct {
+		name    string
+		base    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{"simple", base, "photo.jpg", "/srv/uploads/photo.jpg", false},
+		{"deeply_nested", base, "a/b/c/d/e/f/photo.jpg", "/srv/uploads/a/b/c/d/e/f/photo.jpg", false},
+		{"inner_dotdot", base, "a/b/../c.txt", "/srv/uploads/a/c.txt", false},
+		{"dot", base, ".", "/srv/uploads", false},
+		{"empty", base, "", "/srv/uploads", false},
+		{"unclean_base", "/srv/uploads/../uploads/", "a.txt", "/srv/uploads/a.txt", false},
+		{"relative_escape", base, "../foo", "", true},
+		{"deep_escape", base, "../../../etc/passwd", "", true},
+		{"nested_escape", base, "a/../../foo", "", true},
+		{"sibling_prefix", base, "../uploads2/x", "", true},
+		{"absolute_injection", base, "/etc/passwd", "", true},
+		{"windows_escape", base, `..\..\etc\passwd`, "", true},
+		{"windows_nested", base, `a\b\c.txt`, "/srv/uploads/a/b/c.txt", false},
+		{"windows_absolute", base, `\windows\system32`, "", true},
+		{"root_base", "/", "etc/hosts", "/etc/hosts", false},
+		{"relative_base", "data", "a/b.txt", "data/a/b.txt", false},
+		{"relative_base_escape", "data", "../b.txt", "", true},
+		{"dot_base", ".", "a/b", "a/b", false},
+		{"dot_base_escape", ".", "../a", "", true},
+		{"empty_base", "", "a/b", "a/b", false},
+		{"dotdot_prefixed_name", base, "..foo", "/srv/uploads/..foo", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SafeJoin(filepath.FromSlash(tt.base), filepath.FromSlash(tt.path))
+			if tt.wantErr {
+				if !errors.Is(err, ErrPathTraversal) {
+					t.Fatalf("SafeJoin(%q, %q) = %q, %v, want ErrPathTraversal", tt.base, tt.path, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SafeJoin(%q, %q) unexpected error: %v", tt.base, tt.path, err)
+			}
+			if want := filepath.FromSlash(tt.want); got != want {
+				t.Errorf("SafeJoin(%q, %q) = %q, want %q", tt.base, tt.path, got, want)
+			}
+		})
+	}
+}
@@ -1,5 +1,14 @@
 package slicex
 
+import "cmp"
+
+// Number 為 Sum 接受的數值型別（整數與浮點數，含以其為底層型別的自訂型別）。
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
 // Contains 檢查 slice 是否包含指定元素。
 func Contains[T comparable](s []T, v T) bool {
 	for _, e := range s {
@@ -39,3 +48,120 @@ func Map[T any, R any](s []T, f func(T) R) []R {
 	}
 	return res
 }
+
+// ToMap 以 keyFn 產生的 key 將 slice 轉為 map；key 重複時後出現的元素覆蓋先前的（last wins）。
+func ToMap[T any, K comparable](s []T, keyFn func(T) K) map[K]T {
+	res := make(map[K]T, len(s))
+	for _, e := range s {
+		res[keyFn(e)] = e
+	}
+	return res
+}
+
+// ToMapValues 以 kvFn 產生的 key/value 將 slice 轉為 map；key 重複時後出現的值覆蓋先前的（last wins）。
+func ToMapValues[T any, K comparable, V any](s []T, kvFn func(T) (K, V)) map[K]V {
+	res := make(map[K]V, len(s))
+	for _, e := range s {
+		k, v := kvFn(e)
+		res[k] = v
+	}
+	return res
+}
+
+// Concat 依序串接多個 slice，回傳預先配置容量的新 slice（不與任何輸入共用底層陣列），nil slice 會被略過。
+func Concat[T any](slices ...[]T) []T {
+	n := 0
+	for _, s := range slices {
+		n += len(s)
+	}
+	res := make([]T, 0, n)
+	for _, s := range slices {
+		res = append(res, s...)
+	}
+	return res
+}
+
+// Fill 回傳包含 count 個 value 複本的 slice；count ≤ 0 時回傳空 slice。
+// value 為指標、slice 或 map 時複製的是參考，所有元素指向同一份資料。
+func Fill[T any](value T, count int) []T {
+	if count <= 0 {
+		return []T{}
+	}
+	res := make([]T, count)
+	for i := range res {
+		res[i] = value
+	}
+	return res
+}
+
+// Repeat 回傳將 s 重複 times 次串接的新 slice；times ≤ 0 時回傳空 slice。
+func Repeat[T any](s []T, times int) []T {
+	if times <= 0 {
+		return []T{}
+	}
+	res := make([]T, 0, len(s)*times)
+	for range times {
+		res = append(res, s...)
+	}
+	return res
+}
+
+// Sum 回傳所有元素的總和，空 slice 回傳 0（整數溢位時依 Go 規則環繞）。
+func Sum[T Number](s []T) T {
+	var total T
+	for _, e := range s {
+		total += e
+	}
+	return total
+}
+
+// Max 回傳最大的元素；空 slice 回傳零值與 false。浮點數的 NaN 處理同 builtin max。
+func Max[T cmp.Ordered](s []T) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	res := s[0]
+	for _, e := range s[1:] {
+		res = max(res, e)
+	}
+	return res, true
+}
+
+// Min 回傳最小的元素；空 slice 回傳零值與 false。浮點數的 NaN 處理同 builtin min。
+func Min[T cmp.Ordered](s []T) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	res := s[0]
+	for _, e := range s[1:] {
+		res = min(res, e)
+	}
+	return res, true
+}
+
+// Window 回傳所有長度為 size 的連續視窗（共 len(s)-size+1 個），例如用於移動平均；
+// size ≤ 0 或 size > len(s) 時回傳 nil。
+// 視窗與 s 共用底層陣列（不複製元素），但容量限制為 size，對視窗 append 不會覆寫 s。
+func Window[T any](s []T, size int) [][]T {
+	if size <= 0 || size > len(s) {
+		return nil
+	}
+	res := make([][]T, 0, len(s)-size+1)
+	for i := 0; i+size <= len(s); i++ {
+		res = append(res, s[i:i+size:i+size])
+	}
+	return res
+}
+
+// Clone 回傳 s 的淺拷貝（元素本身不深層複製），用於回傳內部 slice 前避免外部修改；
+// nil 回傳 nil，空 slice 回傳空的非 nil slice。
+func Clone[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	res := make([]T, len(s))
+	copy(res, s)
+	return res
+}
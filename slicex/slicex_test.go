@@ -36,3 +36,249 @@ func TestMap(t *testing.T) {
 		}
 	}
 }
+
+type user struct {
+	ID   int
+	Name string
+}
+
+func TestToMap(t *testing.T) {
+	users := []user{{1, "alice"}, {2, "bob"}, {1, "carol"}}
+	res := ToMap(users, func(u user) int { return u.ID })
+	if len(res) != 2 {
+		t.Fatalf("expected 2 entries, got %v", res)
+	}
+	if res[1].Name != "carol" {
+		t.Fatalf("expected last value to win, got %v", res[1])
+	}
+	if res[2].Name != "bob" {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	if res := ToMap(nil, func(u user) int { return u.ID }); res == nil || len(res) != 0 {
+		t.Fatalf("expected empty map, got %v", res)
+	}
+}
+
+func TestToMapValues(t *testing.T) {
+	users := []user{{1, "alice"}, {2, "bob"}, {1, "carol"}}
+	res := ToMapValues(users, func(u user) (int, string) { return u.ID, u.Name })
+	expected := map[int]string{1: "carol", 2: "bob"}
+	if len(res) != len(expected) {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	for k, v := range expected {
+		if res[k] != v {
+			t.Fatalf("expected %q for key %d, got %q", v, k, res[k])
+		}
+	}
+}
+
+func TestConcat(t *testing.T) {
+	a := []int{1, 2}
+	b := []int{3}
+	c := []int{4, 5, 6}
+	res := Concat(a, nil, b, []int{}, c)
+	expected := []int{1, 2, 3, 4, 5, 6}
+	if len(res) != len(a)+len(b)+len(c) || cap(res) != len(expected) {
+		t.Fatalf("unexpected len/cap: %d/%d", len(res), cap(res))
+	}
+	for i := range expected {
+		if res[i] != expected[i] {
+			t.Fatalf("unexpected result: %v", res)
+		}
+	}
+
+	res[0] = 100
+	res[2] = 300
+	if a[0] != 1 || b[0] != 3 {
+		t.Fatalf("modifying result changed inputs: a=%v b=%v", a, b)
+	}
+
+	// 單一輸入也必須複製，不可回傳原 slice
+	single := Concat(a)
+	single[1] = 200
+	if a[1] != 2 {
+		t.Fatalf("single input aliased: %v", a)
+	}
+
+	if res := Concat[int](); res == nil || len(res) != 0 {
+		t.Fatalf("expected empty slice, got %v", res)
+	}
+}
+
+func TestFill(t *testing.T) {
+	res := Fill(user{1, "alice"}, 3)
+	if len(res) != 3 {
+		t.Fatalf("expected 3 elements, got %v", res)
+	}
+	for i, u := range res {
+		if u != (user{1, "alice"}) {
+			t.Fatalf("element %d = %v", i, u)
+		}
+	}
+	res[0].Name = "bob"
+	if res[1].Name != "alice" {
+		t.Fatalf("elements are not independent copies: %v", res)
+	}
+
+	for _, count := range []int{0, -1} {
+		if res := Fill("x", count); res == nil || len(res) != 0 {
+			t.Fatalf("Fill(x, %d) = %v, want empty slice", count, res)
+		}
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	res := Repeat([]int{1, 2}, 3)
+	expected := []int{1, 2, 1, 2, 1, 2}
+	if len(res) != len(expected) {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	for i := range expected {
+		if res[i] != expected[i] {
+			t.Fatalf("unexpected result: %v", res)
+		}
+	}
+
+	for _, times := range []int{0, -2} {
+		if res := Repeat([]int{1}, times); res == nil || len(res) != 0 {
+			t.Fatalf("Repeat(s, %d) = %v, want empty slice", times, res)
+		}
+	}
+	if res := Repeat([]int(nil), 3); len(res) != 0 {
+		t.Fatalf("expected empty slice, got %v", res)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if got := Sum([]int{1, -2, 3, -4}); got != -2 {
+		t.Fatalf("expected -2, got %d", got)
+	}
+	if got := Sum([]float64{0.5, 1.25, -0.75}); got != 1 {
+		t.Fatalf("expected 1, got %v", got)
+	}
+	if got := Sum([]uint8{7}); got != 7 {
+		t.Fatalf("expected 7, got %d", got)
+	}
+	type cents int64
+	if got := Sum([]cents{100, 250}); got != 350 {
+		t.Fatalf("expected 350, got %d", got)
+	}
+	if got := Sum([]int(nil)); got != 0 {
+		t.Fatalf("expected 0, got %d", got)
+	}
+}
+
+func TestMaxMin(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []int
+		max, min int
+		ok       bool
+	}{
+		{"mixed", []int{3, -1, 7, 0}, 7, -1, true},
+		{"negative", []int{-5, -2, -9}, -2, -9, true},
+		{"single", []int{42}, 42, 42, true},
+		{"duplicates", []int{2, 2, 2}, 2, 2, true},
+		{"empty", []int{}, 0, 0, false},
+		{"nil", nil, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := Max(tt.in); got != tt.max || ok != tt.ok {
+				t.Errorf("Max(%v) = %d, %v, want %d, %v", tt.in, got, ok, tt.max, tt.ok)
+			}
+			if got, ok := Min(tt.in); got != tt.min || ok != tt.ok {
+				t.Errorf("Min(%v) = %d, %v, want %d, %v", tt.in, got, ok, tt.min, tt.ok)
+			}
+		})
+	}
+
+	if got, ok := Max([]string{"banana", "apple", "cherry"}); got != "cherry" || !ok {
+		t.Errorf("Max(strings) = %q, %v", got, ok)
+	}
+	if got, ok := Min([]float64{1.5, -0.5, 2}); got != -0.5 || !ok {
+		t.Errorf("Min(floats) = %v, %v", got, ok)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	tests := []struct {
+		name string
+		size int
+		want [][]int
+	}{
+		{"size_1", 1, [][]int{{1}, {2}, {3}, {4}}},
+		{"size_2", 2, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"size_len", 4, [][]int{{1, 2, 3, 4}}},
+		{"size_gt_len", 5, nil},
+		{"size_zero", 0, nil},
+		{"size_negative", -1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Window(s, tt.size)
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got)
+				}
+				return
+			}
+			if len(got) != len(s)-tt.size+1 || len(got) != len(tt.want) {
+				t.Fatalf("unexpected windows: %v", got)
+			}
+			for i := range tt.want {
+				if len(got[i]) != tt.size {
+					t.Fatalf("window %d has length %d", i, len(got[i]))
+				}
+				for j := range tt.want[i] {
+					if got[i][j] != tt.want[i][j] {
+						t.Fatalf("unexpected windows: %v", got)
+					}
+				}
+			}
+		})
+	}
+
+	if got := Window([]int(nil), 1); got != nil {
+		t.Fatalf("expected nil for nil slice, got %v", got)
+	}
+
+	// append 到視窗不可覆寫原 slice
+	w := Window(s, 2)
+	_ = append(w[0], 100)
+	if s[2] != 3 {
+		t.Fatalf("append to window modified input: %v", s)
+	}
+}
+
+func TestClone(t *testing.T) {
+	s := []int{1, 2, 3}
+	c := Clone(s)
+	if len(c) != len(s) {
+		t.Fatalf("unexpected clone: %v", c)
+	}
+	for i := range s {
+		if c[i] != s[i] {
+			t.Fatalf("unexpected clone: %v", c)
+		}
+	}
+	c[0] = 100
+	if s[0] != 1 {
+		t.Fatalf("modifying clone changed input: %v", s)
+	}
+	s[1] = 200
+	if c[1] != 2 {
+		t.Fatalf("modifying input changed clone: %v", c)
+	}
+
+	if c := Clone([]int(nil)); c != nil {
+		t.Fatalf("expected nil, got %v", c)
+	}
+	if c := Clone([]int{}); c == nil || len(c) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", c)
+	}
+}
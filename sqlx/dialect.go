@@ -0,0 +1,21 @@
+package sqlx
+
+// Dialect 表示 SQL 方言，用於處理各 DB 在引號與跳脫規則上的差異。
+type Dialect string
+
+// 支援的 SQL 方言。
+const (
+	DialectMySQL    Dialect = "mysql"    // 識別字使用 `name`
+	DialectPostgres Dialect = "postgres" // 識別字使用 "name"
+	DialectSQLite   Dialect = "sqlite"   // 識別字使用 "name"
+	DialectANSI     Dialect = "ansi"     // ANSI SQL 標準，識別字使用 "name"
+)
+
+// identifierQuote 回傳方言對應的識別字引號字元。
+// 未知方言一律採用 ANSI SQL 標準的雙引號。
+func (d Dialect) identifierQuote() string {
+	if d == DialectMySQL {
+		return "`"
+	}
+	return `"`
+}
@@ -21,6 +21,12 @@
 //
 //	query := "WHERE name LIKE ? " + sqlx.LikeEscapeClause()
 //
+// 若反斜線在 DB 或 collation 中具特殊意義，可改用自訂轉義字元：
+//
+//	like := sqlx.BuildLikeQueryValueEsc("50%_off", sqlx.LikePosBoth, '!')
+//	// "%50!%!_off%"
+//	query := "WHERE name LIKE ? " + sqlx.LikeEscapeClauseChar('!')
+//
 // # SQL 字串跳脫
 //
 // 基礎 SQL 字串 escape（注意：不能取代 prepared statement）：
@@ -28,6 +34,29 @@
 //	escaped := sqlx.EscapeSQLString("O'Reilly")
 //	// "O\'Reilly"
 //
+// EscapeSQLString 採 MySQL 反斜線跳脫；標準 SQL / Postgres 應將單引號加倍，
+// 請使用 EscapeSQLStringDialect 指定方言：
+//
+//	sqlx.EscapeSQLStringDialect("O'Reilly", sqlx.DialectMySQL)    // "O\'Reilly"
+//	sqlx.EscapeSQLStringDialect("O'Reilly", sqlx.DialectPostgres) // "O''Reilly"
+//
+// # 識別字引號
+//
+// 依方言包住表名或欄位名，並將內含的引號加倍：
+//
+//	col := sqlx.QuoteIdentifier("user_name", sqlx.DialectMySQL)
+//	// "`user_name`"
+//	col := sqlx.QuoteIdentifier("user_name", sqlx.DialectPostgres)
+//	// `"user_name"`
+//
+// # ORDER BY 建構
+//
+// 依欄位白名單產生排序子句（前綴 - 表示 DESC），未允許的欄位回傳 ErrColumnNotAllowed：
+//
+//	allowed := map[string]string{"name": "u.name", "created_at": "u.created_at"}
+//	q, err := sqlx.BuildOrderBy("name,-created_at", allowed)
+//	// "ORDER BY u.name ASC, u.created_at DESC"
+//
 // # Log 格式化
 //
 // 壓縮空白並移除雙重轉義，方便寫 log：
@@ -75,8 +75,60 @@ func LikeEscapeClause() string {
 	return `ESCAPE '\'`
 }
 
+// escapeLikeWith 以指定的轉義字元轉義 LIKE 特殊字元（%, _ 與轉義字元本身）。
+func escapeLikeWith(s string, escapeChar rune) string {
+	esc := string(escapeChar)
+
+	var b strings.Builder
+	b.Grow(len(s) + len(s)/4)
+	for _, r := range s {
+		// 轉義字元本身、% 與 _ 前方加上轉義字元
+		if r == escapeChar || r == '%' || r == '_' {
+			b.WriteString(esc)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// BuildLikeQueryValueEsc 同 BuildLikeQueryValue，但使用自訂轉義字元（例如 '!'）。
+// 適用於反斜線在特定 DB 或 collation 下具特殊意義的情況，
+// 請搭配 LikeEscapeClauseChar(escapeChar) 產生對應的 ESCAPE 子句。
+//
+//	v := sqlx.BuildLikeQueryValueEsc("50%_off", sqlx.LikePosBoth, '!')
+//	// "%50!%!_off%"
+func BuildLikeQueryValueEsc(value string, position string, escapeChar rune) string {
+	escaped := escapeLikeWith(value, escapeChar)
+
+	switch position {
+	case LikePosStart:
+		return escaped + `%` // value%
+	case LikePosEnd:
+		return `%` + escaped // %value
+	case LikePosBoth:
+		return `%` + escaped + `%` // %value%
+	default:
+		return escaped
+	}
+}
+
+// LikeEscapeClauseChar 回傳使用自訂轉義字元的 ESCAPE 子句（例如 ESCAPE '!'）。
+// 若轉義字元為單引號，會依 SQL 規則加倍。
+func LikeEscapeClauseChar(escapeChar rune) string {
+	esc := string(escapeChar)
+	if escapeChar == '\'' {
+		esc = `''`
+	}
+	return `ESCAPE '` + esc + `'`
+}
+
 // EscapeSQLString 基礎 SQL 字串 escape。
 // 注意：不能取代 prepared statement；僅建議用於 log 或「非使用者輸入」的固定字串拼接。
+//
+// 此函式採 MySQL 風格的反斜線跳脫（' -> \'），在標準 SQL / Postgres / SQLite
+// 或 MySQL 啟用 NO_BACKSLASH_ESCAPES 時並不正確（應為 ' -> ''），
+// 且與 stringx 文件所述的 "O''Reilly" 行為不一致。為向後相容保留原行為，
+// 新程式請改用 EscapeSQLStringDialect 明確指定方言。
 func EscapeSQLString(s string) string {
 	// 將反斜線轉義，避免後續跳脫序列混亂
 	s = strings.ReplaceAll(s, `\`, `\\`) // \ -> \\
@@ -89,6 +141,21 @@ func EscapeSQLString(s string) string {
 	return s
 }
 
+// EscapeSQLStringDialect 依方言跳脫 SQL 字串常值（供單引號包住的字串使用）。
+//   - DialectMySQL: 反斜線跳脫（\ -> \\、' -> \'、" -> \"），等同 EscapeSQLString
+//   - 其他方言（Postgres/SQLite/ANSI）: 單引號加倍（' -> ''），反斜線不做處理
+//
+// MySQL 若啟用 NO_BACKSLASH_ESCAPES 模式，反斜線不具跳脫意義，請改用 DialectANSI。
+// 注意：不能取代 prepared statement。
+func EscapeSQLStringDialect(s string, dialect Dialect) string {
+	if dialect == DialectMySQL {
+		return EscapeSQLString(s)
+	}
+
+	// 標準 SQL：字串內的單引號以兩個單引號表示
+	return strings.ReplaceAll(s, `'`, `''`)
+}
+
 // UnescapeBackslash 還原已轉義的反斜線（通常供 log 格式化使用）。
 func UnescapeBackslash(s string) string {
 	// 將 \\ 還原成 \
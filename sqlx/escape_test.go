@@ -80,3 +80,70 @@ func TestFormatSQLForLog(t *testing.T) {
 		t.Fatalf("FormatSQLForLog mismatch:\nwant: %q\ngot:  %q", want, out)
 	}
 }
+
+func TestEscapeSQLStringDialect(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		dialect Dialect
+		want    string
+	}{
+		{"mysql", "O'Reilly", DialectMySQL, `O\'Reilly`},
+		{"postgres", "O'Reilly", DialectPostgres, `O''Reilly`},
+		{"sqlite", "O'Reilly", DialectSQLite, `O''Reilly`},
+		{"ansi", "O'Reilly", DialectANSI, `O''Reilly`},
+		{"mysql_backslash", `a\b'c"d`, DialectMySQL, `a\\b\'c\"d`},
+		{"postgres_backslash_untouched", `a\b'c"d`, DialectPostgres, `a\b''c"d`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeSQLStringDialect(tt.in, tt.dialect); got != tt.want {
+				t.Fatalf("EscapeSQLStringDialect mismatch:\nwant: %q\ngot:  %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildLikeQueryValueEsc(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		position string
+		esc      rune
+		want     string
+	}{
+		{"bang_both", "50%_off", LikePosBoth, '!', `%50!%!_off%`},
+		{"bang_start", "50%_off", LikePosStart, '!', `50!%!_off%`},
+		{"bang_end", "50%_off", LikePosEnd, '!', `%50!%!_off`},
+		{"bang_default", "50%_off", "", '!', `50!%!_off`},
+		{"bang_escapes_itself", "wow!", LikePosBoth, '!', `%wow!!%`},
+		{"bang_backslash_untouched", `a\b`, LikePosBoth, '!', `%a\b%`},
+		{"backslash_same_as_default", `a%b_c\dd`, LikePosBoth, '\\', BuildLikeQueryValue(`a%b_c\dd`, LikePosBoth)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildLikeQueryValueEsc(tt.value, tt.position, tt.esc); got != tt.want {
+				t.Fatalf("BuildLikeQueryValueEsc mismatch:\nwant: %q\ngot:  %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLikeEscapeClauseChar(t *testing.T) {
+	tests := []struct {
+		esc  rune
+		want string
+	}{
+		{'!', `ESCAPE '!'`},
+		{'\\', LikeEscapeClause()},
+		{'\'', `ESCAPE ''''`},
+	}
+
+	for _, tt := range tests {
+		if got := LikeEscapeClauseChar(tt.esc); got != tt.want {
+			t.Fatalf("LikeEscapeClauseChar(%q) mismatch:\nwant: %q\ngot:  %q", tt.esc, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package sqlx
+
+import "strings"
+
+// QuoteIdentifier 以方言對應的引號包住識別字（表名、欄位名），
+// 並將識別字內的引號字元加倍，避免透過欄位名稱注入。
+//   - DialectMySQL:              `name`
+//   - DialectPostgres/SQLite:    "name"
+//
+// 注意：整個 name 視為單一識別字；若為 schema.table 形式，請分別 quote 後再以 . 串接。
+// 適用於排序欄位等來自設定或使用者輸入的識別字，但仍建議搭配白名單使用。
+func QuoteIdentifier(name string, dialect Dialect) string {
+	q := dialect.identifierQuote()
+
+	// 將識別字內的引號加倍（` -> `` 或 " -> ""）
+	escaped := strings.ReplaceAll(name, q, q+q)
+
+	return q + escaped + q
+}
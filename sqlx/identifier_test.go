@@ -0,0 +1,30 @@
+package sqlx
+
+import "testing"
+
+func TestQuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		dialect Dialect
+		want    string
+	}{
+		{"mysql", "user_name", DialectMySQL, "`user_name`"},
+		{"postgres", "user_name", DialectPostgres, `"user_name"`},
+		{"sqlite", "user_name", DialectSQLite, `"user_name"`},
+		{"unknown_dialect", "user_name", Dialect("oracle"), `"user_name"`},
+		{"mysql_embedded_quote", "a`b", DialectMySQL, "`a``b`"},
+		{"postgres_embedded_quote", `a"b`, DialectPostgres, `"a""b"`},
+		{"mysql_injection", "id`; DROP TABLE users; --", DialectMySQL, "`id``; DROP TABLE users; --`"},
+		{"postgres_other_quote_untouched", "a`b", DialectPostgres, "\"a`b\""},
+		{"empty", "", DialectMySQL, "``"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuoteIdentifier(tt.in, tt.dialect); got != tt.want {
+				t.Fatalf("QuoteIdentifier mismatch:\nwant: %q\ngot:  %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package sqlx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrColumnNotAllowed 表示排序欄位不在允許清單中。
+var ErrColumnNotAllowed = errors.New("sqlx: column not allowed")
+
+// BuildOrderBy 依排序規格與欄位白名單產生安全的 ORDER BY 子句。
+// sortSpec 以逗號分隔欄位，前綴 - 表示 DESC（例如 "name,-created_at"）；
+// allowed 將對外欄位名對應到實際欄位名，不在清單中的欄位回傳 ErrColumnNotAllowed。
+//
+//	allowed := map[string]string{"name": "u.name", "created_at": "u.created_at"}
+//	q, err := sqlx.BuildOrderBy("name,-created_at", allowed)
+//	// "ORDER BY u.name ASC, u.created_at DESC"
+//
+// sortSpec 為空（或只有空白、逗號）時回傳空字串。
+// 注意：allowed 的值會原樣輸出，必須是開發者控制的常數，不可來自使用者輸入。
+func BuildOrderBy(sortSpec string, allowed map[string]string) (string, error) {
+	var terms []string
+	for _, field := range strings.Split(sortSpec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		// 解析排序方向
+		dir := "ASC"
+		switch field[0] {
+		case '-':
+			dir = "DESC"
+			field = field[1:]
+		case '+':
+			field = field[1:]
+		}
+
+		col, ok := allowed[field]
+		if !ok || field == "" {
+			return "", fmt.Errorf("%w: %q", ErrColumnNotAllowed, field)
+		}
+		terms = append(terms, col+" "+dir)
+	}
+
+	if len(terms) == 0 {
+		return "", nil
+	}
+	return "ORDER BY " + strings.Join(terms, ", "), nil
+}
@@ -0,0 +1,67 @@
+package sqlx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBuildOrderBy(t *testing.T) {
+	allowed := map[string]string{
+		"name":       "u.name",
+		"created_at": "u.created_at",
+		"id":         "u.id",
+	}
+
+	tests := []struct {
+		name     string
+		sortSpec string
+		want     string
+	}{
+		{"single_asc", "name", "ORDER BY u.name ASC"},
+		{"single_desc", "-created_at", "ORDER BY u.created_at DESC"},
+		{"explicit_asc", "+id", "ORDER BY u.id ASC"},
+		{"multiple", "name,-created_at", "ORDER BY u.name ASC, u.created_at DESC"},
+		{"with_spaces", " name , -id ", "ORDER BY u.name ASC, u.id DESC"},
+		{"empty", "", ""},
+		{"only_commas", ",,", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildOrderBy(tt.sortSpec, allowed)
+			if err != nil {
+				t.Fatalf("BuildOrderBy(%q) error: %v", tt.sortSpec, err)
+			}
+			if got != tt.want {
+				t.Fatalf("BuildOrderBy mismatch:\nwant: %q\ngot:  %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildOrderBy_NotAllowed(t *testing.T) {
+	allowed := map[string]string{"name": "name"}
+
+	tests := []struct {
+		name     string
+		sortSpec string
+	}{
+		{"unknown", "password"},
+		{"unknown_desc", "-password"},
+		{"injection", "name; DROP TABLE users"},
+		{"mixed", "name,-secret"},
+		{"bare_dash", "-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildOrderBy(tt.sortSpec, allowed)
+			if !errors.Is(err, ErrColumnNotAllowed) {
+				t.Fatalf("BuildOrderBy(%q) error = %v, want ErrColumnNotAllowed", tt.sortSpec, err)
+			}
+			if got != "" {
+				t.Fatalf("BuildOrderBy(%q) = %q, want empty on error", tt.sortSpec, got)
+			}
+		})
+	}
+}
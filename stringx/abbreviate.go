@@ -0,0 +1,98 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/vincent119/commons/pathx"
+)
+
+// defaultEllipsis 為 Abbreviate 與 EllipsisPath 使用的省略符號。
+const defaultEllipsis = "…"
+
+// Abbreviate 將字串縮短至最多 max 個 rune（含結尾的 "…"），不切壞 UTF-8：
+//
+//	stringx.Abbreviate("hello world", 8) // "hello w…"
+//
+// 與 Truncate 不同，長度以 rune 計且省略符號計入上限；不需縮短時回傳原字串。
+func Abbreviate(s string, max int) string {
+	return TruncateWithEllipsis(s, max, defaultEllipsis)
+}
+
+// AbbreviateMiddle 保留字串的開頭與結尾，將中間替換為 ellipsis，結果最多 max 個 rune（含 ellipsis）：
+//
+//	stringx.AbbreviateMiddle("0123456789abcdef0123456789abcdef", 16, "…") // "0123456…9abcdef"
+//
+// 兩端保留相同的字數，因此剩餘字數為奇數時結果會比 max 少一個 rune。
+// 不需縮短時回傳原字串；max 小於 ellipsis 長度時回傳 ellipsis 的前 max 個 rune。
+func AbbreviateMiddle(s string, max int, ellipsis string) string {
+	n := utf8.RuneCountInString(s)
+	if n <= max {
+		return s
+	}
+	budget := max - utf8.RuneCountInString(ellipsis)
+	if budget <= 0 {
+		return TruncateRunes(ellipsis, max)
+	}
+
+	half := budget / 2
+	head, _ := runeOffset(s, half)
+	tail, _ := runeOffset(s, n-half)
+	return s[:clusterSafeCut(s, head)] + ellipsis + s[clusterSafeStart(s, tail):]
+}
+
+// EllipsisPath 將路徑縮短至最多 max 個 rune，優先以 "…" 取代中間的目錄而非切斷字元：
+//
+//	stringx.EllipsisPath("/a/b/c/d/y/z", 9) // "/a/…/y/z"
+//
+// 保留第一個目錄與盡可能多的結尾片段（檔名優先），輸出的分隔符會統一為 /（同 pathx.NormalizePathSeparator）。
+// 片段不足以省略或保留頭尾仍超過 max 時，改以 AbbreviateMiddle 縮短。不需縮短時回傳原字串。
+func EllipsisPath(path string, max int) string {
+	if utf8.RuneCountInString(path) <= max {
+		return path
+	}
+
+	p := pathx.NormalizePathSeparator(path)
+	suffix := ""
+	if len(p) > 1 && strings.HasSuffix(p, "/") {
+		p, suffix = p[:len(p)-1], "/"
+	}
+	segs := strings.Split(p, "/")
+
+	head, tail := 1, 1
+	if segs[0] == "" {
+		head = 2 // 絕對路徑保留根目錄與第一個目錄
+	}
+	build := func(head, tail int) string {
+		return strings.Join(segs[:head], "/") + "/" + defaultEllipsis + "/" + strings.Join(segs[len(segs)-tail:], "/") + suffix
+	}
+	fits := func(head, tail int) bool {
+		return utf8.RuneCountInString(build(head, tail)) <= max
+	}
+
+	// 至少要省略一個片段，否則沒有縮短的意義
+	if head+tail >= len(segs) || !fits(head, tail) {
+		return AbbreviateMiddle(p+suffix, max, defaultEllipsis)
+	}
+	for head+tail+1 < len(segs) && fits(head, tail+1) {
+		tail++
+	}
+	for head+tail+1 < len(segs) && fits(head+1, tail) {
+		head++
+	}
+	return build(head, tail)
+}
+
+// clusterSafeStart 將起始位置 start（需為 rune 起點）往後移，避免從組合字元或 ZWJ 序列中間開始。
+func clusterSafeStart(s string, start int) int {
+	for start > 0 && start < len(s) {
+		r, size := utf8.DecodeRuneInString(s[start:])
+		prev, _ := utf8.DecodeLastRuneInString(s[:start])
+		if !unicode.Is(unicode.M, r) && r != zeroWidthJoiner && prev != zeroWidthJoiner {
+			break
+		}
+		start += size
+	}
+	return start
+}
@@ -0,0 +1,85 @@
+package stringx
+
+import "testing"
+
+func TestAbbreviate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		max  int
+		want string
+	}{
+		{"short", "hello", 5, "hello"},
+		{"truncate", "hello world", 8, "hello w…"},
+		{"runes", "台北市政府大樓", 4, "台北市…"},
+		{"max_one", "hello", 1, "…"},
+		{"max_zero", "hello", 0, ""},
+		{"combining", "cafe\u0301s", 6, "cafe\u0301s"},
+		{"combining_cut", "cafe\u0301sss", 5, "caf…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Abbreviate(tt.in, tt.max); got != tt.want {
+				t.Errorf("Abbreviate(%q, %d) = %q, want %q", tt.in, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAbbreviateMiddle(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		max      int
+		ellipsis string
+		want     string
+	}{
+		{"example", "0123456789abcdef0123456789abcdef", 16, "…", "0123456…9abcdef"},
+		{"even_budget", "0123456789", 7, "…", "012…789"},
+		{"short", "abc", 3, "…", "abc"},
+		{"empty", "", 0, "…", ""},
+		{"multi_rune_ellipsis", "0123456789", 8, "...", "01...89"},
+		{"max_below_ellipsis", "0123456789", 2, "...", ".."},
+		{"max_zero", "0123456789", 0, "...", ""},
+		{"budget_one", "0123456789", 2, "…", "…"},
+		{"cjk", "台北市信義區市府路一號", 7, "…", "台北市…路一號"},
+		{"combining_tail", "abcde\u0301fgh", 5, "…", "ab…gh"},
+		{"combining_keeps_cluster", "abcdefgh\u0301ij", 7, "…", "abc…ij"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AbbreviateMiddle(tt.in, tt.max, tt.ellipsis); got != tt.want {
+				t.Errorf("AbbreviateMiddle(%q, %d, %q) = %q, want %q", tt.in, tt.max, tt.ellipsis, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEllipsisPath(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		max  int
+		want string
+	}{
+		{"short", `C:\Users\me`, 20, `C:\Users\me`},
+		{"drop_middle", "/a/b/c/d/y/z", 9, "/a/…/y/z"},
+		{"keep_more_tail", "/a/b/c/d/y/z", 10, "/a/…/d/y/z"},
+		{"relative", "src/internal/pkg/util/file.go", 20, "src/…/util/file.go"},
+		{"windows", `C:\Users\me\projects\app\main.go`, 20, "C:/…/app/main.go"},
+		{"trailing_slash", "/var/lib/docker/volumes/data/", 18, "/var/lib/…/data/"},
+		{"filename_too_long", "/a/b/very-long-file-name.txt", 12, "/a/b/…e.txt"},
+		{"too_few_segments", "/averylongdirectoryname", 10, "/ave…name"},
+		{"max_zero", "/a/b/c", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EllipsisPath(tt.in, tt.max); got != tt.want {
+				t.Errorf("EllipsisPath(%q, %d) = %q, want %q", tt.in, tt.max, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SnakeOptions 為 ToSnakeWithOptions 的選項，零值即為 ToSnake 的預設行為。
+type SnakeOptions struct {
+	// SplitDigits 為 true 時，數字與字母之間也視為單字邊界（"User123ID" → "user_123_id"）。
+	// 預設數字附屬於前一個單字（"user123_id"）。
+	SplitDigits bool
+	// Acronyms 為須視為單一單字的特殊詞（區分大小寫），例如 "OAuth2"、"iOS"。
+	// 僅在單字起點比對，且後方不可緊接小寫字母；多個候選時取最長者。
+	Acronyms []string
+}
+
+// ToSnake 將字串轉為 snake_case，適用於由 Go struct 欄位名稱產生 DB 欄位名稱：
+//   - 小寫（或數字）接大寫時切分："userName" → "user_name"
+//   - 連續大寫視為同一單字，直到最後一個後接小寫的字母："HTMLParser" → "html_parser"、"UserID" → "user_id"
+//   - 數字附屬於前一個單字："User123ID" → "user123_id"
+//   - 空白、- 與 _ 皆視為分隔符，連續分隔符只輸出一個 _，開頭與結尾的分隔符會被移除
+//
+// 大小寫判斷與轉換使用 unicode 套件，非 ASCII 字母同樣保留。
+// ToCamel、ToPascal、ToKebab 與 ToScreamingSnake 共用相同的單字切分規則。
+// 需要切分數字或註冊特殊詞時請使用 ToSnakeWithOptions。
+func ToSnake(s string) string {
+	return ToSnakeWithOptions(s, SnakeOptions{})
+}
+
+// ToSnakeWithOptions 同 ToSnake，但可透過 opts 設定數字邊界與特殊詞：
+//
+//	stringx.ToSnakeWithOptions("OAuth2Token", stringx.SnakeOptions{Acronyms: []string{"OAuth2"}})
+//	// "oauth2_token"（預設為 "o_auth2_token"）
+func ToSnakeWithOptions(s string, opts SnakeOptions) string {
+	words := splitWords(s, opts)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "_")
+}
+
+// ToScreamingSnake 將字串轉為 SCREAMING_SNAKE_CASE（常數命名）："userName" → "USER_NAME"。
+// 單字切分規則同 ToSnake。
+func ToScreamingSnake(s string) string {
+	words := splitWords(s, SnakeOptions{})
+	for i, w := range words {
+		words[i] = strings.ToUpper(w)
+	}
+	return strings.Join(words, "_")
+}
+
+// ToKebab 將字串轉為 kebab-case："UserName" → "user-name"。單字切分規則同 ToSnake。
+func ToKebab(s string) string {
+	words := splitWords(s, SnakeOptions{})
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "-")
+}
+
+// ToPascal 將字串轉為 PascalCase："user_name" → "UserName"、"HTMLParser" → "HtmlParser"。
+// 單字切分規則同 ToSnake；每個單字首字母大寫、其餘小寫，縮寫不保留全大寫。
+//
+// 除了相鄰的單字母單字（"a_b" → "AB"）與數字開頭的單字（"user_1st" → "User1st"）
+// 無法以大小寫表示邊界外，ToSnake(ToPascal(s)) == ToSnake(s)。
+func ToPascal(s string) string {
+	words := splitWords(s, SnakeOptions{})
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, w := range words {
+		b.WriteString(capitalize(w))
+	}
+	return b.String()
+}
+
+// ToCamel 將字串轉為 camelCase："user_name" → "userName"、"HTMLParser" → "htmlParser"。
+// 規則與限制同 ToPascal，但第一個單字全小寫。
+func ToCamel(s string) string {
+	words := splitWords(s, SnakeOptions{})
+	var b strings.Builder
+	b.Grow(len(s))
+	for i, w := range words {
+		if i == 0 {
+			b.WriteString(strings.ToLower(w))
+			continue
+		}
+		b.WriteString(capitalize(w))
+	}
+	return b.String()
+}
+
+// capitalize 將單字首字母轉大寫、其餘轉小寫。
+// 大小寫轉換一律使用 unicode 的預設對應，不處理特定語系規則（例如土耳其語的無點 i）。
+func capitalize(w string) string {
+	r := []rune(strings.ToLower(w))
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// splitWords 將字串切分為單字（保留原始大小寫），規則見 ToSnake 與 SnakeOptions。
+func splitWords(s string, opts SnakeOptions) []string {
+	runes := []rune(s)
+	var (
+		words []string
+		cur   []rune
+	)
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, string(cur))
+			cur = cur[:0]
+		}
+	}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if isWordSeparator(r) {
+			flush()
+			i++
+			continue
+		}
+		if len(cur) > 0 && isWordBoundary(runes, i, opts.SplitDigits) {
+			flush()
+		}
+		if len(cur) == 0 {
+			if n := matchAcronym(runes, i, opts.Acronyms); n > 0 {
+				words = append(words, string(runes[i:i+n]))
+				i += n
+				continue
+			}
+		}
+		cur = append(cur, r)
+		i++
+	}
+	flush()
+	return words
+}
+
+// isWordSeparator 判斷 r 是否為單字分隔符（空白、- 與 _）。
+func isWordSeparator(r rune) bool {
+	return r == '_' || r == '-' || unicode.IsSpace(r)
+}
+
+// isWordBoundary 判斷 runes[i] 之前（與 runes[i-1] 之間）是否為單字邊界，i 必須大於 0。
+func isWordBoundary(runes []rune, i int, splitDigits bool) bool {
+	prev, r := runes[i-1], runes[i]
+	switch {
+	case unicode.IsUpper(r) && (unicode.IsDigit(prev) || unicode.IsLetter(prev) && !unicode.IsUpper(prev)):
+		// camelCase、123ID；無大小寫之分的文字（如中文）視同小寫
+		return true
+	case unicode.IsUpper(r) && unicode.IsUpper(prev):
+		// HTMLParser：連續大寫中，後接小寫的最後一個大寫開始新單字
+		return i+1 < len(runes) && unicode.IsLower(runes[i+1])
+	case splitDigits:
+		return unicode.IsDigit(prev) != unicode.IsDigit(r)
+	default:
+		return false
+	}
+}
+
+// matchAcronym 回傳 runes[i:] 開頭符合的最長特殊詞長度（rune 數），沒有時回傳 0。
+// 特殊詞後方緊接小寫字母時不算符合（避免 "ID" 符合 "IDentity"）。
+func matchAcronym(runes []rune, i int, acronyms []string) int {
+	best := 0
+	for _, a := range acronyms {
+		ar := []rune(a)
+		n := len(ar)
+		if n <= best || i+n > len(runes) || string(runes[i:i+n]) != a {
+			continue
+		}
+		if i+n < len(runes) && unicode.IsLower(runes[i+n]) {
+			continue
+		}
+		best = n
+	}
+	return best
+}
@@ -0,0 +1,65 @@
+package stringx
+
+import "testing"
+
+func TestCaseConversions(t *testing.T) {
+	tests := []struct {
+		in                                     string
+		camel, pascal, kebab, screaming, snake string
+	}{
+		{"user_name", "userName", "UserName", "user-name", "USER_NAME", "user_name"},
+		{"UserName", "userName", "UserName", "user-name", "USER_NAME", "user_name"},
+		{"userName", "userName", "UserName", "user-name", "USER_NAME", "user_name"},
+		{"HTMLParser", "htmlParser", "HtmlParser", "html-parser", "HTML_PARSER", "html_parser"},
+		{"user-id", "userId", "UserId", "user-id", "USER_ID", "user_id"},
+		{"user id", "userId", "UserId", "user-id", "USER_ID", "user_id"},
+		{"UserID", "userId", "UserId", "user-id", "USER_ID", "user_id"},
+		{"already_snake", "alreadySnake", "AlreadySnake", "already-snake", "ALREADY_SNAKE", "already_snake"},
+		{"USER_NAME", "userName", "UserName", "user-name", "USER_NAME", "user_name"},
+		{"User123ID", "user123Id", "User123Id", "user123-id", "USER123_ID", "user123_id"},
+		{"ÜberName", "überName", "ÜberName", "über-name", "ÜBER_NAME", "über_name"},
+		{"", "", "", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := ToCamel(tt.in); got != tt.camel {
+				t.Errorf("ToCamel(%q) = %q, want %q", tt.in, got, tt.camel)
+			}
+			if got := ToPascal(tt.in); got != tt.pascal {
+				t.Errorf("ToPascal(%q) = %q, want %q", tt.in, got, tt.pascal)
+			}
+			if got := ToKebab(tt.in); got != tt.kebab {
+				t.Errorf("ToKebab(%q) = %q, want %q", tt.in, got, tt.kebab)
+			}
+			if got := ToScreamingSnake(tt.in); got != tt.screaming {
+				t.Errorf("ToScreamingSnake(%q) = %q, want %q", tt.in, got, tt.screaming)
+			}
+			if got := ToSnake(tt.in); got != tt.snake {
+				t.Errorf("ToSnake(%q) = %q, want %q", tt.in, got, tt.snake)
+			}
+		})
+	}
+}
+
+func TestCaseConversions_Consistent(t *testing.T) {
+	inputs := []string{
+		"user_name", "HTMLParser", "user-id", "user id", "already_snake", "UserID",
+		"ParseHTTPResponse", "User123ID", "ThisIsA_TEST", "ÜberName", "api_v2_token",
+	}
+	convs := map[string]func(string) string{
+		"ToCamel":          ToCamel,
+		"ToPascal":         ToPascal,
+		"ToKebab":          ToKebab,
+		"ToScreamingSnake": ToScreamingSnake,
+	}
+
+	for _, in := range inputs {
+		want := ToSnake(in)
+		for name, conv := range convs {
+			if got := ToSnake(conv(in)); got != want {
+				t.Errorf("ToSnake(%s(%q)) = %q, want %q", name, in, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package stringx
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// 字元類別判斷：ASCII 版本（IsASCIIxxx）只接受 ASCII 字元，適合帳號、代碼等嚴格的驗證規則；
+// Unicode 版本（IsUnicodexxx）依 unicode 套件判斷，接受 CJK、全形數字等字元，無效的 UTF-8 一律回傳 false。
+// 所有 Is<類別> 函式對空字串皆回傳 false；判斷「空或僅含空白」請使用 IsBlank。
+
+// IsASCIINumeric 回傳 s 是否非空且僅含 ASCII 數字 0-9（不含正負號與小數點）。
+func IsASCIINumeric(s string) bool {
+	return allBytes(s, isASCIIDigit)
+}
+
+// IsUnicodeNumeric 回傳 s 是否非空且僅含 Unicode 十進位數字（unicode.IsDigit，例如全形「１２３」）。
+func IsUnicodeNumeric(s string) bool {
+	return allRunes(s, unicode.IsDigit)
+}
+
+// IsNumeric 等同 IsASCIINumeric。
+func IsNumeric(s string) bool {
+	return IsASCIINumeric(s)
+}
+
+// IsASCIIAlpha 回傳 s 是否非空且僅含 ASCII 字母 a-z、A-Z。
+func IsASCIIAlpha(s string) bool {
+	return allBytes(s, isASCIILetter)
+}
+
+// IsUnicodeAlpha 回傳 s 是否非空且僅含 Unicode 字母（unicode.IsLetter，包含 CJK）。
+func IsUnicodeAlpha(s string) bool {
+	return allRunes(s, unicode.IsLetter)
+}
+
+// IsAlpha 等同 IsASCIIAlpha。
+func IsAlpha(s string) bool {
+	return IsASCIIAlpha(s)
+}
+
+// IsASCIIAlphanumeric 回傳 s 是否非空且僅含 ASCII 字母與數字。
+func IsASCIIAlphanumeric(s string) bool {
+	return allBytes(s, func(c byte) bool { return isASCIILetter(c) || isASCIIDigit(c) })
+}
+
+// IsUnicodeAlphanumeric 回傳 s 是否非空且僅含 Unicode 字母與十進位數字。
+func IsUnicodeAlphanumeric(s string) bool {
+	return allRunes(s, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) })
+}
+
+// IsAlphanumeric 等同 IsASCIIAlphanumeric。
+func IsAlphanumeric(s string) bool {
+	return IsASCIIAlphanumeric(s)
+}
+
+// IsASCII 回傳 s 是否非空且僅含 ASCII 字元（0x00–0x7F，包含控制字元）。
+func IsASCII(s string) bool {
+	return allBytes(s, func(c byte) bool { return c < utf8.RuneSelf })
+}
+
+// IsASCIIPrintable 回傳 s 是否非空且僅含可列印的 ASCII 字元（0x20–0x7E，含空白，不含 \t、\n 等控制字元）。
+func IsASCIIPrintable(s string) bool {
+	return allBytes(s, func(c byte) bool { return c >= 0x20 && c <= 0x7e })
+}
+
+// IsUnicodePrintable 回傳 s 是否非空且僅含可列印字元（unicode.IsPrint：字母、標記、數字、標點與符號），
+// 空白僅接受 ASCII 空白 U+0020，\t、\n 與全形空白皆視為不可列印。
+func IsUnicodePrintable(s string) bool {
+	return allRunes(s, unicode.IsPrint)
+}
+
+// IsPrintable 等同 IsASCIIPrintable。
+func IsPrintable(s string) bool {
+	return IsASCIIPrintable(s)
+}
+
+// IsBlank 回傳 s 是否為空或僅含空白，為 IsEmpty 的別名（名稱較不易與 s == "" 混淆）。
+func IsBlank(s string) bool {
+	return IsEmpty(s)
+}
+
+// allBytes 回傳 s 是否非空且每個 byte 都符合 f。
+func allBytes(s string, f func(byte) bool) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !f(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// allRunes 回傳 s 是否非空、為合法的 UTF-8 且每個 rune 都符合 f。
+func allRunes(s string, f func(rune) bool) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r == utf8.RuneError || !f(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isASCIIDigit 回傳 c 是否為 ASCII 數字。
+func isASCIIDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+// isASCIILetter 回傳 c 是否為 ASCII 字母。
+func isASCIILetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
@@ -0,0 +1,81 @@
+package stringx
+
+import "testing"
+
+func TestCharClass(t *testing.T) {
+	type result struct {
+		asciiNum, uniNum     bool
+		asciiAlpha, uniAlpha bool
+		asciiAlnum, uniAlnum bool
+		ascii                bool
+		asciiPrint, uniPrint bool
+	}
+
+	tests := []struct {
+		name string
+		in   string
+		want result
+	}{
+		{"empty", "", result{}},
+		{"digits", "0123456789", result{true, true, false, false, true, true, true, true, true}},
+		{"fullwidth_digits", "１２３", result{false, true, false, false, false, true, false, false, true}},
+		{"arabic_indic_digits", "٣٤", result{false, true, false, false, false, true, false, false, true}},
+		{"signed_number", "-12", result{false, false, false, false, false, false, true, true, true}},
+		{"decimal", "1.5", result{false, false, false, false, false, false, true, true, true}},
+		{"letters", "abcXYZ", result{false, false, true, true, true, true, true, true, true}},
+		{"cjk", "台北", result{false, false, false, true, false, true, false, false, true}},
+		{"accented", "café", result{false, false, false, true, false, true, false, false, true}},
+		{"alnum", "user123", result{false, false, false, false, true, true, true, true, true}},
+		{"cjk_alnum", "台北101", result{false, false, false, false, false, true, false, false, true}},
+		{"space", "a b", result{false, false, false, false, false, false, true, true, true}},
+		{"tab", "a\tb", result{false, false, false, false, false, false, true, false, false}},
+		{"newline", "a\n", result{false, false, false, false, false, false, true, false, false}},
+		{"nul", "\x00", result{false, false, false, false, false, false, true, false, false}},
+		{"c1_control", "a\u0085", result{false, false, false, false, false, false, false, false, false}},
+		{"ideographic_space", "台\u3000北", result{false, false, false, false, false, false, false, false, false}},
+		{"invalid_utf8", "ab\xff", result{false, false, false, false, false, false, false, false, false}},
+		{"punctuation", "hello, world!", result{false, false, false, false, false, false, true, true, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := result{
+				IsASCIINumeric(tt.in), IsUnicodeNumeric(tt.in),
+				IsASCIIAlpha(tt.in), IsUnicodeAlpha(tt.in),
+				IsASCIIAlphanumeric(tt.in), IsUnicodeAlphanumeric(tt.in),
+				IsASCII(tt.in),
+				IsASCIIPrintable(tt.in), IsUnicodePrintable(tt.in),
+			}
+			if got != tt.want {
+				t.Errorf("%q:\n got  %+v\n want %+v", tt.in, got, tt.want)
+			}
+
+			// 未標示的名稱等同 ASCII 版本
+			if IsNumeric(tt.in) != got.asciiNum || IsAlpha(tt.in) != got.asciiAlpha ||
+				IsAlphanumeric(tt.in) != got.asciiAlnum || IsPrintable(tt.in) != got.asciiPrint {
+				t.Errorf("%q: unqualified predicates differ from ASCII versions", tt.in)
+			}
+		})
+	}
+}
+
+func TestIsBlank(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"", true},
+		{" \t\n", true},
+		{"\u3000", true},
+		{" a ", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsBlank(tt.in); got != tt.want {
+			t.Errorf("IsBlank(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+		if IsBlank(tt.in) != IsEmpty(tt.in) {
+			t.Errorf("IsBlank(%q) != IsEmpty", tt.in)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CountOptions 為 CountOccurrencesWithOptions 的選項。
+type CountOptions struct {
+	// Overlapping 為 true 時計算重疊的出現次數（"aaaa" 中的 "aa" 為 3 次）；預設不重疊（2 次）。
+	Overlapping bool
+}
+
+// CountWords 回傳以 Unicode 空白分隔的單字數（同 len(strings.Fields(s))，但不配置記憶體）。
+// 未以空白分隔的 CJK 文字會被視為一個單字。
+func CountWords(s string) int {
+	n := 0
+	inWord := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			inWord = false
+			continue
+		}
+		if !inWord {
+			n++
+			inWord = true
+		}
+	}
+	return n
+}
+
+// CountOccurrences 回傳 sub 在 s 中不重疊出現的次數；sub 為空或比 s 長時回傳 0
+// （不同於 strings.Count 對空字串回傳 rune 數 + 1）。
+func CountOccurrences(s, sub string) int {
+	return CountOccurrencesWithOptions(s, sub, CountOptions{})
+}
+
+// CountOccurrencesWithOptions 同 CountOccurrences，並可依 opts 計算重疊的出現次數：
+//
+//	stringx.CountOccurrencesWithOptions("aaaa", "aa", stringx.CountOptions{Overlapping: true}) // 3
+func CountOccurrencesWithOptions(s, sub string, opts CountOptions) int {
+	if sub == "" || len(sub) > len(s) {
+		return 0
+	}
+	if !opts.Overlapping {
+		return strings.Count(s, sub)
+	}
+
+	n := 0
+	for {
+		i := strings.Index(s, sub)
+		if i < 0 {
+			return n
+		}
+		n++
+		_, size := utf8.DecodeRuneInString(s[i:])
+		s = s[i+size:] // 前進一個 rune，允許下一次比對與本次重疊
+	}
+}
+
+// RuneLength 回傳 s 的 rune 數（utf8.RuneCountInString 的易讀別名）；
+// 顯示寬度請使用 VisualWidth。
+func RuneLength(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// VisualWidth 回傳 s 在等寬字型（終端機、純文字表格）中的顯示欄數：
+// 東亞全形字元為 2、組合字元與 ZWJ 為 0、其餘為 1，與 WrapOptions.EastAsianWidth 使用相同的寬度定義：
+//
+//	stringx.VisualWidth("abc")  // 3
+//	stringx.VisualWidth("台北") // 4
+func VisualWidth(s string) int {
+	return textWidth(s, true)
+}
+
+// LimitWords 回傳 s 的前 n 個單字（以 Unicode 空白分隔），用於摘要預覽。
+// 單字之間的原始空白保留，頭尾空白移除；n ≤ 0 時回傳空字串：
+//
+//	stringx.LimitWords("  The quick brown fox ", 2) // "The quick"
+func LimitWords(s string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	inWord := false
+	for i, r := range s {
+		if !unicode.IsSpace(r) {
+			inWord = true
+			continue
+		}
+		if inWord {
+			n--
+			if n == 0 {
+				return s[:i]
+			}
+		}
+		inWord = false
+	}
+	return strings.TrimRightFunc(s, unicode.IsSpace)
+}
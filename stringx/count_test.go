@@ -0,0 +1,111 @@
+package stringx
+
+import "testing"
+
+func TestCountWords(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"", 0},
+		{"   ", 0},
+		{"hello", 1},
+		{"  the quick\tbrown\nfox  ", 4},
+		{"台北\u3000高雄", 2},
+		{"台北市政府", 1},
+	}
+
+	for _, tt := range tests {
+		if got := CountWords(tt.in); got != tt.want {
+			t.Errorf("CountWords(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCountOccurrences(t *testing.T) {
+	tests := []struct {
+		name        string
+		s, sub      string
+		want        int
+		overlapping int
+	}{
+		{"basic", "a,b,c", ",", 2, 2},
+		{"repeated", "aaaa", "aa", 2, 3},
+		{"overlap_pattern", "abababa", "aba", 2, 3},
+		{"unicode", "台北台北台", "台北台", 1, 2},
+		{"none", "hello", "x", 0, 0},
+		{"empty_sub", "hello", "", 0, 0},
+		{"empty_s", "", "a", 0, 0},
+		{"sub_longer", "ab", "abc", 0, 0},
+		{"equal", "abc", "abc", 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountOccurrences(tt.s, tt.sub); got != tt.want {
+				t.Errorf("CountOccurrences(%q, %q) = %d, want %d", tt.s, tt.sub, got, tt.want)
+			}
+			opts := CountOptions{Overlapping: true}
+			if got := CountOccurrencesWithOptions(tt.s, tt.sub, opts); got != tt.overlapping {
+				t.Errorf("CountOccurrencesWithOptions(%q, %q, overlapping) = %d, want %d", tt.s, tt.sub, got, tt.overlapping)
+			}
+		})
+	}
+}
+
+func TestRuneLengthVisualWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		runes int
+		width int
+	}{
+		{"empty", "", 0, 0},
+		{"ascii", "hello", 5, 5},
+		{"cjk", "台北", 2, 4},
+		{"mixed", "ID: 台北101", 9, 11},
+		{"fullwidth", "ＡＢ", 2, 4},
+		{"combining", "cafe\u0301", 5, 4},
+		{"emoji", "\U0001F600", 1, 2},
+		{"zwj_sequence", "\U0001F468\u200d\U0001F469", 3, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RuneLength(tt.in); got != tt.runes {
+				t.Errorf("RuneLength(%q) = %d, want %d", tt.in, got, tt.runes)
+			}
+			if got := VisualWidth(tt.in); got != tt.width {
+				t.Errorf("VisualWidth(%q) = %d, want %d", tt.in, got, tt.width)
+			}
+		})
+	}
+}
+
+func TestLimitWords(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{"basic", "The quick brown fox", 2, "The quick"},
+		{"trim", "  The quick brown fox ", 2, "The quick"},
+		{"keeps_inner_space", "a  b\tc d", 3, "a  b\tc"},
+		{"exact", "a b c", 3, "a b c"},
+		{"fewer_words", " a b ", 5, "a b"},
+		{"zero", "a b", 0, ""},
+		{"negative", "a b", -1, ""},
+		{"empty", "", 3, ""},
+		{"blank", "   ", 1, ""},
+		{"cjk", "台北 高雄 台中", 2, "台北 高雄"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LimitWords(tt.in, tt.n); got != tt.want {
+				t.Errorf("LimitWords(%q, %d) = %q, want %q", tt.in, tt.n, got, tt.want)
+			}
+		})
+	}
+}
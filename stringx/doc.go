@@ -4,7 +4,166 @@
 //
 // 將 CamelCase 轉為 snake_case：
 //
-//	s := stringx.ToSnake("UserID") // "user_i_d"
+//	s := stringx.ToSnake("UserID")     // "user_id"
+//	s := stringx.ToSnake("HTMLParser") // "html_parser"
+//	s := stringx.ToSnake("User123ID")  // "user123_id"
+//
+// 切分數字或註冊特殊詞：
+//
+//	opts := stringx.SnakeOptions{SplitDigits: true, Acronyms: []string{"OAuth2"}}
+//	s := stringx.ToSnakeWithOptions("OAuth2Token", opts) // "oauth2_token"
+//
+// 其他命名風格（與 ToSnake 共用單字切分規則）：
+//
+//	stringx.ToCamel("user_name")         // "userName"
+//	stringx.ToPascal("user_name")        // "UserName"
+//	stringx.ToKebab("UserName")          // "user-name"
+//	stringx.ToScreamingSnake("userName") // "USER_NAME"
+//
+// # 切分與串接
+//
+// 切分設定值（移除空白並捨棄空元素，空字串回傳空的非 nil slice）：
+//
+//	stringx.SplitAndTrim(" a, b ,,c ", ",")            // []string{"a", "b", "c"}
+//	stringx.FieldsByComma("read, write,")              // []string{"read", "write"}
+//	stringx.SplitAndTrimN("key = a = b", "=", 2)       // []string{"key", "a = b"}
+//	stringx.JoinNonEmpty(", ", "Taipei", "", "Taiwan") // "Taipei, Taiwan"
+//
+// 處理簡單的雙引號（不需為一行 header 引入 encoding/csv）：
+//
+//	opts := stringx.SplitOptions{Quoted: true}
+//	stringx.SplitAndTrimWithOptions(`a,"b,c",d`, ",", opts) // []string{"a", "b,c", "d"}
+//
+// # 多值比對
+//
+// 比對多個子字串、前綴、後綴或值（Fold 版本以 Unicode case folding 比對且不配置記憶體）。
+// 清單為空時 Any 系列回傳 false、All 系列回傳 true：
+//
+//	stringx.ContainsAny(path, "/admin", "/internal")
+//	stringx.HasAnyPrefixFold(r.URL.Path, "/healthz", "/metrics")
+//	stringx.HasAnySuffixFold(filename, ".jpg", ".png")
+//	stringx.EqualsAnyFold(r.Method, "GET", "HEAD")
+//
+// # 共同前綴與後綴
+//
+// 產生精簡的設定差異或將 metric 名稱分組（CommonPrefixByRune 不會切壞多位元組字元）：
+//
+//	p := stringx.LongestCommonPrefix("http_requests_total", "http_request_size") // "http_request"
+//	s := stringx.LongestCommonSuffix("api.example.com", "www.example.com")       // ".example.com"
+//	p := stringx.CommonPrefixByRune("台北市", "台中市")                                // "台"
+//	short := stringx.TrimPrefixAll(names, p)
+//
+// 單一前綴或後綴的不區分大小寫比對：
+//
+//	stringx.HasPrefixFold(auth, "bearer ")
+//	stringx.HasSuffixFold(host, ".example.com")
+//
+// # 模糊比對
+//
+// 以 rune 計算的編輯距離與相似度（CJK 與 emoji 皆以字元計），以及 "did you mean" 提示：
+//
+//	stringx.Levenshtein("台北", "台中")   // 1
+//	stringx.Similarity("apple", "apply") // 0.8
+//	s, d, ok := stringx.ClosestMatch("statu", []string{"start", "status"}, 2) // "status", 1, true
+//
+// # 首字大寫與反轉
+//
+// 以 rune 為單位處理（取代已棄用的 strings.Title 與逐 byte 反轉）：
+//
+//	stringx.Capitalize("élan")             // "Élan"
+//	stringx.Uncapitalize("UserName")       // "userName"
+//	stringx.TitleCase("hello world")       // "Hello World"
+//	stringx.TitleCaseIdentifier("user_id") // "User Id"（表單標籤）
+//	stringx.Reverse("hello世界")             // "界世olleh"
+//
+// 標題中維持小寫的虛詞（第一個與最後一個單字除外）：
+//
+//	opts := stringx.TitleCaseOptions{SmallWords: stringx.DefaultTitleSmallWords}
+//	stringx.TitleCaseWithOptions("the lord of the rings", opts) // "The Lord of the Rings"
+//
+// # 折行與縮排
+//
+// 多行說明文字與 email 內容的排版（以 rune 計算寬度，保留每行的 \n 或 \r\n）：
+//
+//	stringx.Wrap("The quick brown fox jumps", 10) // "The quick\nbrown fox\njumps"
+//	stringx.Indent("a\nb\n", "  ")                // "  a\n  b\n"
+//	stringx.Dedent("\n    a\n      b\n")          // "\na\n  b\n"
+//	stringx.TrimLines("a  \nb\t\n")               // "a\nb\n"
+//
+// 終端機輸出可改以東亞字寬計算，或統一換行符號：
+//
+//	opts := stringx.WrapOptions{EastAsianWidth: true, Newline: "\n"}
+//	s := stringx.WrapWithOptions(text, 80, opts)
+//
+// # 空白與控制字元
+//
+// 清理使用者輸入，避免日誌偽造與 CSV 損毀（不需修改時回傳原字串，不配置記憶體）：
+//
+//	stringx.CollapseWhitespace("  a \t\n b ")        // "a b"
+//	stringx.StripControl("a\x00b\x1b[0m\n")          // "ab[0m\n"（保留 \n、\t）
+//	stringx.SanitizeForLog("evil\r\nINFO fake", 256) // `evil\nINFO fake`
+//
+// SanitizeForLog 一次完成移除控制字元、合併空白、將換行跳脫為字面 \n 並以 rune 截斷，
+// 適合記錄 User-Agent、header 等外部輸入。
+//
+// # 子字串擷取
+//
+// 擷取標記之間或分隔符號前後的子字串（找不到或標記為空時回傳 "", false，而非整個字串）：
+//
+//	v, ok := stringx.Between("user=[alice] id=[42]", "[", "]") // "alice", true
+//	all := stringx.BetweenAll("[a] [b] [c", "[", "]")         // []string{"a", "b"}
+//	ext, ok := stringx.SubstringAfterLast("photo.tar.gz", ".") // "gz", true
+//	dir, ok := stringx.SubstringBeforeLast("a/b/c.txt", "/")   // "a/b", true
+//
+// 標記巢狀時不做配對，取第一個 start 之後最近的 end（Between("f(g(x))", "(", ")") 為 "g(x"）。
+// 以 rune 索引取子字串，超出範圍時自動限制而不會 panic：
+//
+//	s := stringx.SafeSubstring("hello世界", 5, 100) // "世界"
+//
+// # 計數與寬度
+//
+// 自由文字欄位的統計與顯示寬度（VisualWidth 與 WrapOptions.EastAsianWidth 使用相同的寬度定義）：
+//
+//	stringx.CountWords("the quick\tbrown fox")   // 4
+//	stringx.CountOccurrences("aaaa", "aa")       // 2（不重疊，overlapping 選項為 3）
+//	stringx.RuneLength("台北")                     // 2
+//	stringx.VisualWidth("台北")                    // 4
+//	stringx.LimitWords("The quick brown fox", 2) // "The quick"
+//
+// # 樣板替換
+//
+// 以 ${name} 或 $name 組合通知訊息與 S3 key（$$ 為字面的 $，變數值不會再次展開）：
+//
+//	s, err := stringx.Substitute("reports/${tenant}/${date}/summary.csv", vars)
+//	if errors.Is(err, stringx.ErrUnknownVariable) { ... } // 錯誤訊息列出所有缺少的變數
+//	s := stringx.SubstituteLoose("Hi ${name}", vars)     // 缺少的變數原樣保留
+//	s, err := stringx.SubstituteFunc("$HOME/.config", os.LookupEnv)
+//
+// # 設定值解析
+//
+// 解析環境變數風格的布林值與數字（空字串不視為 false，而是錯誤 / 預設值）：
+//
+//	ok, err := stringx.ParseBool("Yes")                  // true（接受 BoolTrueValues / BoolFalseValues）
+//	debug := stringx.ParseBoolDefault(os.Getenv("DEBUG"), false)
+//	n := stringx.ParseIntDefault(os.Getenv("WORKERS"), 4)
+//	f := stringx.ParseFloatDefault(os.Getenv("RATIO"), 0.5)
+//
+// 移除千分位符號：
+//
+//	opts := stringx.ParseNumberOptions{ThousandsSeparator: ','}
+//	n := stringx.ParseIntDefaultWithOptions("1,234", 0, opts) // 1234
+//
+// # 字元類別
+//
+// 驗證輸入的字元類別，ASCII 與 Unicode 版本分別命名（未標示的 IsNumeric 等同 ASCII 版本）；
+// 空字串一律回傳 false，判斷空白請用 IsBlank：
+//
+//	stringx.IsASCIINumeric("0912")   // true
+//	stringx.IsASCIINumeric("１２")     // false（全形數字）
+//	stringx.IsUnicodeNumeric("１２")   // true
+//	stringx.IsUnicodeAlpha("台北")     // true
+//	stringx.IsASCIIPrintable("a\tb") // false
+//	stringx.IsBlank("  ")            // true
 //
 // # SQL 跳脫
 //
@@ -30,9 +189,29 @@
 //	stringx.IsEmpty("")      // true
 //	stringx.IsEmpty("  ")    // true
 //
-// 截斷字串：
+// 預設值與 fallback 鏈（與 IsEmpty 相同，僅含空白視為空）：
+//
+//	stringx.DefaultIfEmpty("  ", "def")                     // "def"
+//	stringx.Coalesce(os.Getenv("ADDR"), *flagAddr, ":8080") // 第一個非空值
+//	stringx.ValueOr(req.Nickname, user.Nickname)            // *string 為 nil 或空時取預設值
+//
+// 截斷字串（Truncate 以 byte 計且可能切壞 UTF-8，非 ASCII 字串請用安全版本）：
+//
+//	s := stringx.Truncate("hello world", 5)                    // "hello"
+//	s := stringx.TruncateRunes("hello世界", 6)                   // "hello世"
+//	s := stringx.TruncateBytes("hello世界", 7)                   // "hello"（不切在 rune 中間）
+//	s := stringx.TruncateWithEllipsis("hello world", 8, "...") // "hello..."
+//
+// 避免在單字中間截斷（UI 預覽）：
+//
+//	opts := stringx.TruncateOptions{Ellipsis: "…", KeepWords: true}
+//	s := stringx.TruncateWithOptions("The quick brown fox", 13, opts) // "The quick…"
+//
+// 顯示用的縮寫（以 rune 計且省略符號計入上限），可保留頭尾或省略中間的目錄：
 //
-//	s := stringx.Truncate("hello world", 5) // "hello"
+//	s := stringx.Abbreviate("hello world", 8)                // "hello w…"
+//	s := stringx.AbbreviateMiddle(requestID, 16, "…")        // "0123456…9abcdef"
+//	s := stringx.EllipsisPath(`C:\Users\me\app\main.go`, 16) // "C:/…/app/main.go"
 //
 // JSON 跳脫：
 //
@@ -0,0 +1,64 @@
+package stringx
+
+// DefaultIfEmpty 在 s 為空（依 IsEmpty，僅含空白也算空）時回傳 def，否則回傳 s（不移除空白）。
+//
+//	region := stringx.DefaultIfEmpty(os.Getenv("AWS_REGION"), "ap-northeast-1")
+func DefaultIfEmpty(s, def string) string {
+	if IsEmpty(s) {
+		return def
+	}
+	return s
+}
+
+// Coalesce 回傳 values 中第一個不為空（依 IsEmpty）的值，全部為空時回傳 ""，
+// 用於「環境變數 → flag → 預設值」等 fallback 鏈：
+//
+//	addr := stringx.Coalesce(os.Getenv("ADDR"), *flagAddr, ":8080")
+//
+// "   " 視為空，不會被選中。
+func Coalesce(values ...string) string {
+	return CoalesceFunc(IsEmpty, values...)
+}
+
+// FirstNonEmpty 同 Coalesce，但以 ok 區分「全部為空」與「選中的值」，
+// 適用於全部為空時需要回報錯誤的情況：
+//
+//	dsn, ok := stringx.FirstNonEmpty(os.Getenv("DATABASE_URL"), cfg.DSN)
+//	if !ok {
+//	    return errors.New("database DSN is required")
+//	}
+func FirstNonEmpty(values ...string) (string, bool) {
+	for _, v := range values {
+		if !IsEmpty(v) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// CoalesceFunc 同 Coalesce，但以 isEmpty 判斷值是否為空，回傳第一個 isEmpty 為 false 的值，
+// 全部為空時回傳 ""。CoalesceFunc(IsEmpty, values...) 等同 Coalesce(values...)：
+//
+//	// 只把 "" 視為空（"  " 為有效值）
+//	v := stringx.CoalesceFunc(func(s string) bool { return s == "" }, a, b)
+func CoalesceFunc(isEmpty func(string) bool, values ...string) string {
+	for _, v := range values {
+		if !isEmpty(v) {
+			return v
+		}
+	}
+	return ""
+}
+
+// ValueOr 取出選填字串欄位的值：p 為 nil 或 *p 為空（依 IsEmpty）時回傳 def。
+//
+//	type UpdateUserRequest struct {
+//	    Nickname *string `json:"nickname"`
+//	}
+//	name := stringx.ValueOr(req.Nickname, user.Nickname)
+func ValueOr(p *string, def string) string {
+	if p == nil {
+		return def
+	}
+	return DefaultIfEmpty(*p, def)
+}
@@ -0,0 +1,96 @@
+package stringx
+
+import "testing"
+
+func TestDefaultIfEmpty(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		def  string
+		want string
+	}{
+		{"empty", "", "def", "def"},
+		{"whitespace", " \t\n", "def", "def"},
+		{"value", "v", "def", "v"},
+		{"keeps_padding", " v ", "def", " v "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultIfEmpty(tt.s, tt.def); got != tt.want {
+				t.Errorf("DefaultIfEmpty(%q, %q) = %q, want %q", tt.s, tt.def, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{"none", nil, ""},
+		{"all_empty", []string{"", "  ", "\t"}, ""},
+		{"first", []string{"a", "b"}, "a"},
+		{"skip_whitespace", []string{"", "   ", "flag", "default"}, "flag"},
+		{"last", []string{"", "default"}, "default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Coalesce(tt.values...); got != tt.want {
+				t.Errorf("Coalesce(%q) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirstNonEmpty(t *testing.T) {
+	if v, ok := FirstNonEmpty("", " ", "x", "y"); !ok || v != "x" {
+		t.Errorf("FirstNonEmpty() = %q, %v, want x, true", v, ok)
+	}
+	if v, ok := FirstNonEmpty("", "  "); ok || v != "" {
+		t.Errorf("FirstNonEmpty(all empty) = %q, %v, want \"\", false", v, ok)
+	}
+	if _, ok := FirstNonEmpty(); ok {
+		t.Error("FirstNonEmpty() ok = true, want false")
+	}
+}
+
+func TestCoalesceFunc(t *testing.T) {
+	strict := func(s string) bool { return s == "" }
+
+	if got := CoalesceFunc(strict, "", "  ", "x"); got != "  " {
+		t.Errorf("CoalesceFunc(strict) = %q, want %q", got, "  ")
+	}
+	if got := CoalesceFunc(IsEmpty, "", "  ", "x"); got != "x" {
+		t.Errorf("CoalesceFunc(IsEmpty) = %q, want %q", got, "x")
+	}
+	if got := CoalesceFunc(func(string) bool { return true }, "a", "b"); got != "" {
+		t.Errorf("CoalesceFunc(always empty) = %q, want empty", got)
+	}
+}
+
+func TestValueOr(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name string
+		p    *string
+		want string
+	}{
+		{"nil", nil, "def"},
+		{"empty", ptr(""), "def"},
+		{"whitespace", ptr("  "), "def"},
+		{"value", ptr("amy"), "amy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValueOr(tt.p, "def"); got != tt.want {
+				t.Errorf("ValueOr() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
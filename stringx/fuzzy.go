@@ -0,0 +1,99 @@
+package stringx
+
+import "unicode/utf8"
+
+// Levenshtein 回傳 a 與 b 的編輯距離（插入、刪除、取代各計 1），以 rune 而非 byte 比較，
+// 因此 "台北" 與 "台中" 的距離為 1。使用兩列 DP，額外記憶體為 O(min(m, n))。
+//
+//	stringx.Levenshtein("kitten", "sitting") // 3
+func Levenshtein(a, b string) int {
+	d, _ := levenshtein([]rune(a), []rune(b), -1)
+	return d
+}
+
+// Similarity 回傳 a 與 b 的相似度（0..1）：1 - Levenshtein / 較長字串的 rune 數。
+// 兩者皆為空字串時回傳 1。
+//
+//	stringx.Similarity("apple", "apply") // 0.8
+func Similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	d, _ := levenshtein(ra, rb, -1)
+	return 1 - float64(d)/float64(longest)
+}
+
+// ClosestMatch 回傳 candidates 中與 target 編輯距離最小、且不超過 maxDistance 的候選字串與其距離，
+// 用於 CLI 的 "did you mean" 提示。距離相同時取先出現者；沒有符合的候選（或 maxDistance < 0）時 ok 為 false。
+// 計算過程中某一列的最小值超過目前門檻時即提前結束該候選。
+//
+//	s, d, ok := stringx.ClosestMatch("statu", []string{"start", "status", "stop"}, 2)
+//	// s = "status", d = 1, ok = true
+func ClosestMatch(target string, candidates []string, maxDistance int) (string, int, bool) {
+	if maxDistance < 0 {
+		return "", 0, false
+	}
+
+	rt := []rune(target)
+	var (
+		best  string
+		bestD int
+		found bool
+	)
+	limit := maxDistance
+	for _, c := range candidates {
+		// 長度差已超過門檻時不可能符合
+		if diff := utf8.RuneCountInString(c) - len(rt); diff > limit || -diff > limit {
+			continue
+		}
+		d, ok := levenshtein(rt, []rune(c), limit)
+		if !ok {
+			continue
+		}
+		best, bestD, found = c, d, true
+		if d == 0 {
+			break
+		}
+		limit = d - 1 // 之後的候選必須更接近才會取代
+	}
+	return best, bestD, found
+}
+
+// levenshtein 計算 a 與 b 的編輯距離。limit >= 0 時，若距離必定超過 limit 則提前回傳 ok=false。
+func levenshtein(a, b []rune, limit int) (int, bool) {
+	// 以較短的字串作為列，降低記憶體用量
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i, ra := range a {
+		cur[0] = i + 1
+		rowMin := cur[0]
+		for j, rb := range b {
+			cost := 1
+			if ra == rb {
+				cost = 0
+			}
+			cur[j+1] = min(prev[j+1]+1, cur[j]+1, prev[j]+cost)
+			rowMin = min(rowMin, cur[j+1])
+		}
+		if limit >= 0 && rowMin > limit {
+			return 0, false
+		}
+		prev, cur = cur, prev
+	}
+
+	d := prev[len(b)]
+	if limit >= 0 && d > limit {
+		return 0, false
+	}
+	return d, true
+}
@@ -0,0 +1,96 @@
+package stringx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{"both_empty", "", "", 0},
+		{"one_empty", "abc", "", 3},
+		{"other_empty", "", "abc", 3},
+		{"equal", "status", "status", 0},
+		{"kitten_sitting", "kitten", "sitting", 3},
+		{"flaw_lawn", "flaw", "lawn", 2},
+		{"transposition", "stauts", "status", 2},
+		{"case_sensitive", "Go", "go", 1},
+		{"cjk_substitution", "台北", "台中", 1},
+		{"cjk_insertion", "台北市", "台北", 1},
+		{"emoji", "a😀b", "ab", 1},
+		{"combining_mark_is_rune", "é", "e", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Levenshtein(tt.a, tt.b); got != tt.want {
+				t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := Levenshtein(tt.b, tt.a); got != tt.want {
+				t.Errorf("Levenshtein(%q, %q) = %d, want %d (symmetric)", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"both_empty", "", "", 1},
+		{"one_empty", "abc", "", 0},
+		{"equal", "abc", "abc", 1},
+		{"one_edit", "apple", "apply", 0.8},
+		{"completely_different", "abc", "xyz", 0},
+		{"cjk", "台北市", "台北", 2.0 / 3.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Similarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Similarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	commands := []string{"start", "status", "stop", "restart"}
+
+	tests := []struct {
+		name        string
+		target      string
+		candidates  []string
+		maxDistance int
+		want        string
+		wantD       int
+		wantOK      bool
+	}{
+		{"typo", "statu", commands, 2, "status", 1, true},
+		{"tie_on_typo", "stauts", commands, 2, "start", 2, true},
+		{"exact", "stop", commands, 2, "stop", 0, true},
+		{"too_far", "deploy", commands, 2, "", 0, false},
+		{"tie_keeps_first", "stat", []string{"star", "stab"}, 1, "star", 1, true},
+		{"better_later", "resart", []string{"start", "restart"}, 3, "restart", 1, true},
+		{"no_candidates", "stop", nil, 2, "", 0, false},
+		{"negative_max", "stop", commands, -1, "", 0, false},
+		{"zero_max_requires_exact", "stpo", commands, 0, "", 0, false},
+		{"cjk", "台北市", []string{"台中市", "高雄市"}, 1, "台中市", 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, d, ok := ClosestMatch(tt.target, tt.candidates, tt.maxDistance)
+			if got != tt.want || d != tt.wantD || ok != tt.wantOK {
+				t.Errorf("ClosestMatch(%q, %q, %d) = (%q, %d, %v), want (%q, %d, %v)",
+					tt.target, tt.candidates, tt.maxDistance, got, d, ok, tt.want, tt.wantD, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ContainsAny 回報 s 是否包含 subs 中任一子字串；subs 為空時回傳 false。
+// 注意與 strings.ContainsAny 不同：比對的是完整子字串而非個別字元。
+// 與 strings.Contains 相同，空字串子字串視為包含。
+//
+//	stringx.ContainsAny(path, "/admin", "/internal")
+func ContainsAny(s string, subs ...string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAnyFold 同 ContainsAny，但以 Unicode case folding 比對（同 strings.EqualFold 的語意），不配置記憶體。
+func ContainsAnyFold(s string, subs ...string) bool {
+	for _, sub := range subs {
+		if containsFold(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll 回報 s 是否包含 subs 中所有子字串；subs 為空時回傳 true。
+func ContainsAll(s string, subs ...string) bool {
+	for _, sub := range subs {
+		if !strings.Contains(s, sub) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAllFold 同 ContainsAll，但以 Unicode case folding 比對，不配置記憶體。
+func ContainsAllFold(s string, subs ...string) bool {
+	for _, sub := range subs {
+		if !containsFold(s, sub) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAnyPrefix 回報 s 是否以 prefixes 中任一前綴開頭；prefixes 為空時回傳 false。
+//
+//	stringx.HasAnyPrefix(r.URL.Path, "/healthz", "/metrics")
+func HasAnyPrefix(s string, prefixes ...string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyPrefixFold 同 HasAnyPrefix，但以 Unicode case folding 比對，不配置記憶體。
+func HasAnyPrefixFold(s string, prefixes ...string) bool {
+	for _, p := range prefixes {
+		if hasPrefixFold(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnySuffix 回報 s 是否以 suffixes 中任一後綴結尾；suffixes 為空時回傳 false。
+//
+//	stringx.HasAnySuffix(filename, ".jpg", ".png")
+func HasAnySuffix(s string, suffixes ...string) bool {
+	for _, suf := range suffixes {
+		if strings.HasSuffix(s, suf) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnySuffixFold 同 HasAnySuffix，但以 Unicode case folding 比對，不配置記憶體。
+func HasAnySuffixFold(s string, suffixes ...string) bool {
+	for _, suf := range suffixes {
+		if hasSuffixFold(s, suf) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPrefixFold 回報 s 是否以 prefix 開頭，以 Unicode case folding 比對且不配置記憶體（HTTP header、hostname 比對）：
+//
+//	stringx.HasPrefixFold(r.Header.Get("Authorization"), "bearer ")
+func HasPrefixFold(s, prefix string) bool {
+	return hasPrefixFold(s, prefix)
+}
+
+// HasSuffixFold 回報 s 是否以 suffix 結尾，以 Unicode case folding 比對且不配置記憶體：
+//
+//	stringx.HasSuffixFold(host, ".example.com")
+func HasSuffixFold(s, suffix string) bool {
+	return hasSuffixFold(s, suffix)
+}
+
+// EqualsAny 回報 s 是否等於 values 中任一值；values 為空時回傳 false。
+func EqualsAny(s string, values ...string) bool {
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// EqualsAnyFold 同 EqualsAny，但以 strings.EqualFold 比對。
+//
+//	stringx.EqualsAnyFold(r.Method, "GET", "HEAD")
+func EqualsAnyFold(s string, values ...string) bool {
+	for _, v := range values {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold 回報 s 中是否有以 case folding 比對等於 sub 的子字串。
+func containsFold(s, sub string) bool {
+	if sub == "" {
+		return true
+	}
+	for i := 0; i < len(s); {
+		if hasPrefixFold(s[i:], sub) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	return false
+}
+
+// hasPrefixFold 回報 s 是否以 case folding 比對的 prefix 開頭。
+// 逐 rune 比對而非比較 byte 長度，因為 fold 後相等的字元 UTF-8 長度可能不同（例如 K 與 U+212A KELVIN SIGN）。
+func hasPrefixFold(s, prefix string) bool {
+	i := 0
+	for _, pr := range prefix {
+		if i >= len(s) {
+			return false
+		}
+		sr, size := rune(s[i]), 1
+		if sr >= utf8.RuneSelf {
+			sr, size = utf8.DecodeRuneInString(s[i:])
+		}
+		if !equalFoldRune(sr, pr) {
+			return false
+		}
+		i += size
+	}
+	return true
+}
+
+// hasSuffixFold 回報 s 是否以 case folding 比對的 suffix 結尾。
+func hasSuffixFold(s, suffix string) bool {
+	for suffix != "" {
+		if s == "" {
+			return false
+		}
+		sr, ssize := utf8.DecodeLastRuneInString(s)
+		fr, fsize := utf8.DecodeLastRuneInString(suffix)
+		if !equalFoldRune(sr, fr) {
+			return false
+		}
+		s, suffix = s[:len(s)-ssize], suffix[:len(suffix)-fsize]
+	}
+	return true
+}
+
+// equalFoldRune 回報兩個 rune 在 Unicode simple case folding 下是否相等（同 strings.EqualFold）。
+func equalFoldRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	if a < utf8.RuneSelf && b < utf8.RuneSelf {
+		// ASCII 快速路徑
+		if 'A' <= a && a <= 'Z' {
+			a += 'a' - 'A'
+		}
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		return a == b
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package stringx
+
+import "testing"
+
+func TestContainsAnyAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		subs        []string
+		wantAny     bool
+		wantAnyFold bool
+		wantAll     bool
+		wantAllFold bool
+	}{
+		{"empty_list", "abc", nil, false, false, true, true},
+		{"one_match", "/api/admin/users", []string{"/admin", "/internal"}, true, true, false, false},
+		{"all_match", "/api/admin/users", []string{"/admin", "users"}, true, true, true, true},
+		{"fold_only", "/API/Admin", []string{"/admin", "/api"}, false, true, false, true},
+		{"no_match", "hello", []string{"x", "y"}, false, false, false, false},
+		{"empty_sub", "hello", []string{""}, true, true, true, true},
+		{"empty_s", "", []string{"a"}, false, false, false, false},
+		{"unicode_fold", "Straße ΣΊΣΥΦΟΣ", []string{"σίσυφος"}, false, true, false, true},
+		{"kelvin_sign", "10\u212a", []string{"10k"}, false, true, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsAny(tt.s, tt.subs...); got != tt.wantAny {
+				t.Errorf("ContainsAny(%q, %q) = %v, want %v", tt.s, tt.subs, got, tt.wantAny)
+			}
+			if got := ContainsAnyFold(tt.s, tt.subs...); got != tt.wantAnyFold {
+				t.Errorf("ContainsAnyFold(%q, %q) = %v, want %v", tt.s, tt.subs, got, tt.wantAnyFold)
+			}
+			if got := ContainsAll(tt.s, tt.subs...); got != tt.wantAll {
+				t.Errorf("ContainsAll(%q, %q) = %v, want %v", tt.s, tt.subs, got, tt.wantAll)
+			}
+			if got := ContainsAllFold(tt.s, tt.subs...); got != tt.wantAllFold {
+				t.Errorf("ContainsAllFold(%q, %q) = %v, want %v", tt.s, tt.subs, got, tt.wantAllFold)
+			}
+		})
+	}
+}
+
+func TestHasAnyPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		prefixes []string
+		want     bool
+		wantFold bool
+	}{
+		{"empty_list", "/healthz", nil, false, false},
+		{"match", "/healthz/live", []string{"/metrics", "/healthz"}, true, true},
+		{"fold_only", "/HealthZ", []string{"/healthz"}, false, true},
+		{"prefix_longer", "/he", []string{"/healthz"}, false, false},
+		{"empty_prefix", "abc", []string{""}, true, true},
+		{"unicode_fold", "ÉCOLE publique", []string{"école"}, false, true},
+		{"different_byte_length", "\u212aelvin", []string{"kel"}, false, true},
+		{"partial_rune", "é", []string{"e"}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasAnyPrefix(tt.s, tt.prefixes...); got != tt.want {
+				t.Errorf("HasAnyPrefix(%q, %q) = %v, want %v", tt.s, tt.prefixes, got, tt.want)
+			}
+			if got := HasAnyPrefixFold(tt.s, tt.prefixes...); got != tt.wantFold {
+				t.Errorf("HasAnyPrefixFold(%q, %q) = %v, want %v", tt.s, tt.prefixes, got, tt.wantFold)
+			}
+		})
+	}
+}
+
+func TestHasAnySuffix(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		suffixes []string
+		want     bool
+		wantFold bool
+	}{
+		{"empty_list", "photo.jpg", nil, false, false},
+		{"match", "photo.jpg", []string{".png", ".jpg"}, true, true},
+		{"fold_only", "PHOTO.JPG", []string{".jpg"}, false, true},
+		{"suffix_longer", "g", []string{".jpg"}, false, false},
+		{"empty_suffix", "abc", []string{""}, true, true},
+		{"unicode_fold", "hello ΣΟΦΊΑ", []string{"σοφία"}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasAnySuffix(tt.s, tt.suffixes...); got != tt.want {
+				t.Errorf("HasAnySuffix(%q, %q) = %v, want %v", tt.s, tt.suffixes, got, tt.want)
+			}
+			if got := HasAnySuffixFold(tt.s, tt.suffixes...); got != tt.wantFold {
+				t.Errorf("HasAnySuffixFold(%q, %q) = %v, want %v", tt.s, tt.suffixes, got, tt.wantFold)
+			}
+		})
+	}
+}
+
+func TestEqualsAny(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		values   []string
+		want     bool
+		wantFold bool
+	}{
+		{"empty_list", "GET", nil, false, false},
+		{"match", "GET", []string{"HEAD", "GET"}, true, true},
+		{"fold_only", "get", []string{"GET"}, false, true},
+		{"no_match", "POST", []string{"GET", "HEAD"}, false, false},
+		{"unicode_fold", "ǅ", []string{"ǆ"}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualsAny(tt.s, tt.values...); got != tt.want {
+				t.Errorf("EqualsAny(%q, %q) = %v, want %v", tt.s, tt.values, got, tt.want)
+			}
+			if got := EqualsAnyFold(tt.s, tt.values...); got != tt.wantFold {
+				t.Errorf("EqualsAnyFold(%q, %q) = %v, want %v", tt.s, tt.values, got, tt.wantFold)
+			}
+		})
+	}
+}
+
+func TestFoldVariants_NoAlloc(t *testing.T) {
+	s := "/API/V1/Admin/Users.JSON"
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = ContainsAnyFold(s, "/internal", "/admin")
+		_ = ContainsAllFold(s, "/api", "users")
+		_ = HasAnyPrefixFold(s, "/healthz", "/api")
+		_ = HasAnySuffixFold(s, ".xml", ".json")
+		_ = EqualsAnyFold("get", "HEAD", "GET")
+	})
+	if allocs != 0 {
+		t.Errorf("allocs = %v, want 0", allocs)
+	}
+}
@@ -0,0 +1,102 @@
+package stringx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidBool 表示字串不是 ParseBool 接受的布林值（包含空字串）。
+var ErrInvalidBool = errors.New("stringx: invalid boolean")
+
+// BoolTrueValues 與 BoolFalseValues 為 ParseBool 接受的值（不區分大小寫，比對前會移除頭尾空白），
+// 匯出供文件與錯誤訊息列出，請勿修改。
+var (
+	BoolTrueValues  = []string{"true", "yes", "on", "1", "t", "y"}
+	BoolFalseValues = []string{"false", "no", "off", "0", "f", "n"}
+)
+
+// ParseNumberOptions 為 ParseIntDefaultWithOptions 與 ParseFloatDefaultWithOptions 的選項。
+type ParseNumberOptions struct {
+	// ThousandsSeparator 非 0 時，解析前移除所有此字元（例如 ',' 使 "1,234" 解析為 1234）；
+	// 不檢查分組位置。預設不移除。
+	ThousandsSeparator rune
+}
+
+// ParseBool 解析環境變數風格的布林值，接受 BoolTrueValues 與 BoolFalseValues（不區分大小寫）：
+//
+//	stringx.ParseBool("Yes") // true, nil
+//	stringx.ParseBool("off") // false, nil
+//
+// 空字串不視為 false，與其他無法辨識的值一樣回傳包裝 ErrInvalidBool 的錯誤。
+func ParseBool(s string) (bool, error) {
+	v := strings.TrimSpace(s)
+	match := func(token string) bool { return strings.EqualFold(token, v) }
+	switch {
+	case v == "":
+		return false, fmt.Errorf("%w: empty string", ErrInvalidBool)
+	case slices.ContainsFunc(BoolTrueValues, match):
+		return true, nil
+	case slices.ContainsFunc(BoolFalseValues, match):
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w: %q", ErrInvalidBool, s)
+	}
+}
+
+// ParseBoolDefault 同 ParseBool，但空字串或無法辨識的值回傳 def：
+//
+//	debug := stringx.ParseBoolDefault(os.Getenv("DEBUG"), false)
+func ParseBoolDefault(s string, def bool) bool {
+	v, err := ParseBool(s)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// ParseIntDefault 解析十進位整數（移除頭尾空白），空字串、格式錯誤或溢位時回傳 def。
+func ParseIntDefault(s string, def int) int {
+	return ParseIntDefaultWithOptions(s, def, ParseNumberOptions{})
+}
+
+// ParseIntDefaultWithOptions 同 ParseIntDefault，並可依 opts 移除千分位符號：
+//
+//	opts := stringx.ParseNumberOptions{ThousandsSeparator: ','}
+//	stringx.ParseIntDefaultWithOptions(" 1,234 ", 0, opts) // 1234
+func ParseIntDefaultWithOptions(s string, def int, opts ParseNumberOptions) int {
+	n, err := strconv.Atoi(normalizeNumber(s, opts))
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// ParseFloatDefault 解析浮點數（移除頭尾空白），空字串、格式錯誤、溢位或 NaN / Inf 時回傳 def。
+func ParseFloatDefault(s string, def float64) float64 {
+	return ParseFloatDefaultWithOptions(s, def, ParseNumberOptions{})
+}
+
+// ParseFloatDefaultWithOptions 同 ParseFloatDefault，並可依 opts 移除千分位符號：
+//
+//	opts := stringx.ParseNumberOptions{ThousandsSeparator: ','}
+//	stringx.ParseFloatDefaultWithOptions("1,234.5", 0, opts) // 1234.5
+func ParseFloatDefaultWithOptions(s string, def float64, opts ParseNumberOptions) float64 {
+	f, err := strconv.ParseFloat(normalizeNumber(s, opts), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return def
+	}
+	return f
+}
+
+// normalizeNumber 移除頭尾空白，並依 opts 移除千分位符號。
+func normalizeNumber(s string, opts ParseNumberOptions) string {
+	s = strings.TrimSpace(s)
+	if opts.ThousandsSeparator != 0 {
+		s = strings.ReplaceAll(s, string(opts.ThousandsSeparator), "")
+	}
+	return s
+}
@@ -0,0 +1,153 @@
+package stringx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseBool(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    bool
+		wantErr bool
+	}{
+		{"true", true, false},
+		{"TRUE", true, false},
+		{"Yes", true, false},
+		{"on", true, false},
+		{"1", true, false},
+		{"t", true, false},
+		{"Y", true, false},
+		{" yes\n", true, false},
+		{"false", false, false},
+		{"No", false, false},
+		{"OFF", false, false},
+		{"0", false, false},
+		{"f", false, false},
+		{"n", false, false},
+		{"", false, true},
+		{"   ", false, true},
+		{"2", false, true},
+		{"enabled", false, true},
+		{"yess", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseBool(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidBool) {
+					t.Fatalf("ParseBool(%q) error = %v, want ErrInvalidBool", tt.in, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseBool(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBoolTokens(t *testing.T) {
+	for _, v := range BoolTrueValues {
+		if got, err := ParseBool(v); err != nil || !got {
+			t.Errorf("ParseBool(%q) = %v, %v, want true", v, got, err)
+		}
+	}
+	for _, v := range BoolFalseValues {
+		if got, err := ParseBool(v); err != nil || got {
+			t.Errorf("ParseBool(%q) = %v, %v, want false", v, got, err)
+		}
+	}
+}
+
+func TestParseBoolDefault(t *testing.T) {
+	tests := []struct {
+		in   string
+		def  bool
+		want bool
+	}{
+		{"", true, true},
+		{"", false, false},
+		{"invalid", true, true},
+		{"off", true, false},
+		{"on", false, true},
+	}
+
+	for _, tt := range tests {
+		if got := ParseBoolDefault(tt.in, tt.def); got != tt.want {
+			t.Errorf("ParseBoolDefault(%q, %v) = %v, want %v", tt.in, tt.def, got, tt.want)
+		}
+	}
+}
+
+func TestParseIntDefault(t *testing.T) {
+	comma := ParseNumberOptions{ThousandsSeparator: ','}
+	tests := []struct {
+		name string
+		in   string
+		opts ParseNumberOptions
+		want int
+	}{
+		{"plain", "42", ParseNumberOptions{}, 42},
+		{"trim", " \t-7\n", ParseNumberOptions{}, -7},
+		{"plus", "+5", ParseNumberOptions{}, 5},
+		{"empty", "", ParseNumberOptions{}, -1},
+		{"blank", "  ", ParseNumberOptions{}, -1},
+		{"invalid", "12abc", ParseNumberOptions{}, -1},
+		{"float", "1.5", ParseNumberOptions{}, -1},
+		{"overflow", "99999999999999999999", ParseNumberOptions{}, -1},
+		{"separator_without_option", "1,234", ParseNumberOptions{}, -1},
+		{"separator", " 1,234,567 ", comma, 1234567},
+		{"custom_separator", "1_000", ParseNumberOptions{ThousandsSeparator: '_'}, 1000},
+		{"separator_empty", ",", comma, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseIntDefaultWithOptions(tt.in, -1, tt.opts); got != tt.want {
+				t.Errorf("ParseIntDefaultWithOptions(%q, -1, %+v) = %d, want %d", tt.in, tt.opts, got, tt.want)
+			}
+			if tt.opts == (ParseNumberOptions{}) {
+				if got := ParseIntDefault(tt.in, -1); got != tt.want {
+					t.Errorf("ParseIntDefault(%q, -1) = %d, want %d", tt.in, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseFloatDefault(t *testing.T) {
+	comma := ParseNumberOptions{ThousandsSeparator: ','}
+	tests := []struct {
+		name string
+		in   string
+		opts ParseNumberOptions
+		want float64
+	}{
+		{"plain", "1.5", ParseNumberOptions{}, 1.5},
+		{"trim", " -0.25 ", ParseNumberOptions{}, -0.25},
+		{"integer", "3", ParseNumberOptions{}, 3},
+		{"exponent", "1e3", ParseNumberOptions{}, 1000},
+		{"empty", "", ParseNumberOptions{}, -1},
+		{"invalid", "abc", ParseNumberOptions{}, -1},
+		{"nan", "NaN", ParseNumberOptions{}, -1},
+		{"inf", "+Inf", ParseNumberOptions{}, -1},
+		{"overflow", "1e400", ParseNumberOptions{}, -1},
+		{"separator", "1,234.5", comma, 1234.5},
+		{"separator_with_decimal_comma", "1.234,5", ParseNumberOptions{ThousandsSeparator: '.'}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseFloatDefaultWithOptions(tt.in, -1, tt.opts); got != tt.want {
+				t.Errorf("ParseFloatDefaultWithOptions(%q, -1, %+v) = %v, want %v", tt.in, tt.opts, got, tt.want)
+			}
+			if tt.opts == (ParseNumberOptions{}) {
+				if got := ParseFloatDefault(tt.in, -1); got != tt.want {
+					t.Errorf("ParseFloatDefault(%q, -1) = %v, want %v", tt.in, got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package stringx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// LongestCommonPrefix 回傳所有字串的最長共同前綴（以 byte 比對），ss 為空時回傳 ""：
+//
+//	stringx.LongestCommonPrefix("http_requests_total", "http_request_duration") // "http_request"
+//
+// 以 byte 比對可能在多位元組字元中間截斷（"é" 與 "è" 的共同前綴為半個字元），
+// 非 ASCII 字串請使用 CommonPrefixByRune。
+func LongestCommonPrefix(ss ...string) string {
+	if len(ss) == 0 {
+		return ""
+	}
+	p := ss[0]
+	for _, s := range ss[1:] {
+		p = commonPrefix(p, s)
+		if p == "" {
+			break
+		}
+	}
+	return p
+}
+
+// CommonPrefixByRune 同 LongestCommonPrefix，但不會在多位元組字元中間截斷：
+//
+//	stringx.CommonPrefixByRune("台北市", "台中市") // "台"
+func CommonPrefixByRune(ss ...string) string {
+	p := LongestCommonPrefix(ss...)
+	cut := len(p)
+	for _, s := range ss {
+		for cut > 0 && cut < len(s) && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+	}
+	return p[:cut]
+}
+
+// LongestCommonSuffix 回傳所有字串的最長共同後綴（以 byte 比對），ss 為空時回傳 ""：
+//
+//	stringx.LongestCommonSuffix("api.example.com", "www.example.com") // ".example.com"
+//
+// 與 LongestCommonPrefix 相同，可能從多位元組字元中間開始，非 ASCII 字串請使用 CommonSuffixByRune。
+func LongestCommonSuffix(ss ...string) string {
+	if len(ss) == 0 {
+		return ""
+	}
+	p := ss[0]
+	for _, s := range ss[1:] {
+		p = commonSuffix(p, s)
+		if p == "" {
+			break
+		}
+	}
+	return p
+}
+
+// CommonSuffixByRune 同 LongestCommonSuffix，但不會從多位元組字元中間開始。
+func CommonSuffixByRune(ss ...string) string {
+	p := LongestCommonSuffix(ss...)
+	start := 0
+	for start < len(p) && !utf8.RuneStart(p[start]) {
+		start++
+	}
+	return p[start:]
+}
+
+// TrimPrefixAll 回傳移除 prefix 後的新 slice（不以 prefix 開頭的元素保持不變），不修改 ss：
+//
+//	p := stringx.LongestCommonPrefix(names...)
+//	short := stringx.TrimPrefixAll(names, p)
+func TrimPrefixAll(ss []string, prefix string) []string {
+	res := make([]string, len(ss))
+	for i, s := range ss {
+		res[i] = strings.TrimPrefix(s, prefix)
+	}
+	return res
+}
+
+// commonSuffix 回傳 a 與 b 的共同後綴。
+func commonSuffix(a, b string) string {
+	n := min(len(a), len(b))
+	for i := 1; i <= n; i++ {
+		if a[len(a)-i] != b[len(b)-i] {
+			return a[len(a)-i+1:]
+		}
+	}
+	return a[len(a)-n:]
+}
@@ -0,0 +1,105 @@
+package stringx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLongestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     []string
+		want   string
+		byRune string
+	}{
+		{"empty_input", nil, "", ""},
+		{"single", []string{"abc"}, "abc", "abc"},
+		{"metrics", []string{"http_requests_total", "http_request_duration", "http_request_size"}, "http_request", "http_request"},
+		{"none", []string{"abc", "xyz"}, "", ""},
+		{"one_empty", []string{"abc", ""}, "", ""},
+		{"identical", []string{"same", "same"}, "same", "same"},
+		{"is_prefix", []string{"abc", "ab"}, "ab", "ab"},
+		{"cjk", []string{"台北市", "台中市"}, "台", "台"},
+		// "é" (C3 A9) 與 "è" (C3 A8) 共用第一個 byte
+		{"split_multibyte", []string{"café", "cafè"}, "caf\xc3", "caf"},
+		{"cjk_split", []string{"中", "丰"}, "\xe4\xb8", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LongestCommonPrefix(tt.in...); got != tt.want {
+				t.Errorf("LongestCommonPrefix(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			if got := CommonPrefixByRune(tt.in...); got != tt.byRune {
+				t.Errorf("CommonPrefixByRune(%q) = %q, want %q", tt.in, got, tt.byRune)
+			}
+		})
+	}
+}
+
+func TestLongestCommonSuffix(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     []string
+		want   string
+		byRune string
+	}{
+		{"empty_input", nil, "", ""},
+		{"single", []string{"abc"}, "abc", "abc"},
+		{"hosts", []string{"api.example.com", "www.example.com"}, ".example.com", ".example.com"},
+		{"none", []string{"abc", "xyz"}, "", ""},
+		{"is_suffix", []string{"abc", "bc"}, "bc", "bc"},
+		{"one_empty", []string{"", "abc"}, "", ""},
+		{"cjk", []string{"台北市", "台中市"}, "市", "市"},
+		// "é" (C3 A9) 與 "©" (C2 A9) 共用最後一個 byte
+		{"split_multibyte", []string{"éx", "©x"}, "\xa9x", "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LongestCommonSuffix(tt.in...); got != tt.want {
+				t.Errorf("LongestCommonSuffix(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			if got := CommonSuffixByRune(tt.in...); got != tt.byRune {
+				t.Errorf("CommonSuffixByRune(%q) = %q, want %q", tt.in, got, tt.byRune)
+			}
+		})
+	}
+}
+
+func TestTrimPrefixAll(t *testing.T) {
+	in := []string{"app_requests", "app_errors", "other"}
+	got := TrimPrefixAll(in, "app_")
+	want := []string{"requests", "errors", "other"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TrimPrefixAll() = %q, want %q", got, want)
+	}
+	if in[0] != "app_requests" {
+		t.Errorf("input modified: %q", in)
+	}
+	if got := TrimPrefixAll(nil, "x"); len(got) != 0 {
+		t.Errorf("TrimPrefixAll(nil) = %q, want empty", got)
+	}
+}
+
+func TestHasPrefixSuffixFold(t *testing.T) {
+	tests := []struct {
+		s, affix       string
+		prefix, suffix bool
+	}{
+		{"Bearer token", "bearer ", true, false},
+		{"API.Example.COM", ".example.com", false, true},
+		{"abc", "", true, true},
+		{"ab", "abc", false, false},
+		{"\u212aelvin", "kelvin", true, true}, // Kelvin sign
+	}
+
+	for _, tt := range tests {
+		if got := HasPrefixFold(tt.s, tt.affix); got != tt.prefix {
+			t.Errorf("HasPrefixFold(%q, %q) = %v, want %v", tt.s, tt.affix, got, tt.prefix)
+		}
+		if got := HasSuffixFold(tt.s, tt.affix); got != tt.suffix {
+			t.Errorf("HasSuffixFold(%q, %q) = %v, want %v", tt.s, tt.affix, got, tt.suffix)
+		}
+	}
+}
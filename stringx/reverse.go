@@ -0,0 +1,43 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Reverse 以字元為單位反轉字串，不會切壞 UTF-8（"hello世界" → "界世olleh"）。
+// 簡單的組合序列會視為一個單位保留原順序：基底字元與其後的組合字元（例如 e + U+0301），
+// 以及以 ZWJ 連接的 emoji 序列。其他 grapheme 規則（例如膚色修飾符、國旗的區域指示符號）不處理。
+func Reverse(s string) string {
+	if len(s) <= 1 {
+		return s
+	}
+
+	// 先記錄每個單位的起點，再由後往前輸出
+	starts := make([]int, 0, len(s))
+	var prev rune = -1
+	for i, r := range s {
+		if len(starts) == 0 || !joinsPrevious(prev, r) {
+			starts = append(starts, i)
+		}
+		prev = r
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	end := len(s)
+	for k := len(starts) - 1; k >= 0; k-- {
+		b.WriteString(s[starts[k]:end])
+		end = starts[k]
+	}
+	return b.String()
+}
+
+// joinsPrevious 判斷 r 是否應與前一個字元 prev 視為同一單位（規則同 clusterSafeCut）。
+func joinsPrevious(prev, r rune) bool {
+	if r == utf8.RuneError {
+		return false
+	}
+	return unicode.Is(unicode.M, r) || r == zeroWidthJoiner || prev == zeroWidthJoiner
+}
@@ -0,0 +1,39 @@
+package stringx
+
+import "testing"
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"single", "a", "a"},
+		{"ascii", "hello", "olleh"},
+		{"cjk", "hello世界", "界世olleh"},
+		{"emoji", "a😀b", "b😀a"},
+		{"precomposed", "caf\u00e9!", "!\u00e9fac"},
+		{"combining_mark", "cafe\u0301!", "!e\u0301fac"},
+		{"multiple_marks", "a\u0323\u0301b", "ba\u0323\u0301"},
+		{"zwj_sequence", "x\U0001F468\u200d\U0001F469\u200d\U0001F467y", "y\U0001F468\u200d\U0001F469\u200d\U0001F467x"},
+		{"variation_selector", "\u2764\ufe0f!", "!\u2764\ufe0f"},
+		{"leading_mark", "\u0301ab", "ba\u0301"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reverse(tt.in); got != tt.want {
+				t.Errorf("Reverse(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReverse_Involution(t *testing.T) {
+	for _, in := range []string{"hello世界", "cafe\u0301", "a😀b", "x\U0001F468\u200d\U0001F469y"} {
+		if got := Reverse(Reverse(in)); got != in {
+			t.Errorf("Reverse(Reverse(%q)) = %q", in, got)
+		}
+	}
+}
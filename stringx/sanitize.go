@@ -0,0 +1,151 @@
+package stringx
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CollapseWhitespace 將連續的 Unicode 空白（含 \t、\n、全形空白）合併為單一空白，並移除頭尾空白：
+//
+//	stringx.CollapseWhitespace("  hello \t\n world  ") // "hello world"
+//
+// 字串不需修改時直接回傳原字串，不配置記憶體。
+func CollapseWhitespace(s string) string {
+	if isCollapsed(s, false) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	pending := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			pending = b.Len() > 0
+			continue
+		}
+		if pending {
+			b.WriteByte(' ')
+			pending = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// StripControl 移除 C0（U+0000–U+001F、U+007F）與 C1（U+0080–U+009F）控制字元，保留 \n 與 \t：
+//
+//	stringx.StripControl("a\x00b\r\nc\x1b[31m") // "ab\nc[31m"
+//
+// 字串不需修改時直接回傳原字串，不配置記憶體。需要自訂保留的字元時使用 StripControlExcept。
+func StripControl(s string) string {
+	return StripControlExcept(s, '\n', '\t')
+}
+
+// StripControlExcept 同 StripControl，但僅保留 allowed 中的控制字元（未指定時移除所有控制字元）：
+//
+//	stringx.StripControlExcept("a\tb\nc")           // "abc"
+//	stringx.StripControlExcept("a\r\nb", '\r', '\n') // "a\r\nb"
+func StripControlExcept(s string, allowed ...rune) string {
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return unicode.IsControl(r) && !slices.Contains(allowed, r)
+	})
+	if i < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	b.WriteString(s[:i])
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsControl(r) || slices.Contains(allowed, r) {
+			b.WriteString(s[i : i+size]) // 保留原始 byte（含無效的 UTF-8）
+		}
+		i += size
+	}
+	return b.String()
+}
+
+// SanitizeForLog 將使用者輸入（User-Agent、header 等）整理為可安全寫入單行日誌的字串：
+//   - 換行（\n 或 \r\n）轉為字面 `\n`，避免偽造日誌行
+//   - 其餘空白（含 \t、單獨的 \r）合併為單一空白，並移除頭尾空白與換行
+//   - 移除其餘控制字元（例如 ANSI escape 的 \x1b、NUL）
+//   - 截斷至最多 maxLen 個 rune（不切壞 UTF-8、組合字元與 `\n`），maxLen ≤ 0 時不截斷
+//
+// 範例：
+//
+//	stringx.SanitizeForLog("evil\r\nINFO fake entry\x1b[0m", 0) // `evil\nINFO fake entry[0m`
+//
+// 字串不需修改時直接回傳原字串，不配置記憶體。
+func SanitizeForLog(s string, maxLen int) string {
+	if isCollapsed(s, true) {
+		return truncateForLog(s, maxLen)
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	// gap 暫存兩段內容之間的空白與換行，遇到下一段內容時才寫入，以此移除頭尾空白。
+	var gapBuf [16]byte
+	gap := gapBuf[:0]
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == '\n' || r == '\r' && strings.HasPrefix(s[i+size:], "\n"):
+			if r == '\r' {
+				size++
+			}
+			gap = append(gap, `\n`...)
+		case unicode.IsSpace(r):
+			if len(gap) == 0 || gap[len(gap)-1] != ' ' {
+				gap = append(gap, ' ')
+			}
+		case unicode.IsControl(r):
+			// 移除
+		default:
+			if b.Len() > 0 {
+				b.Write(gap)
+			}
+			gap = gap[:0]
+			b.WriteString(s[i : i+size]) // 保留原始 byte（含無效的 UTF-8）
+		}
+		i += size
+	}
+	return truncateForLog(b.String(), maxLen)
+}
+
+// isCollapsed 回傳 s 是否已無頭尾空白、連續空白與非 ' ' 的空白；noControl 為 true 時也不得含控制字元。
+func isCollapsed(s string, noControl bool) bool {
+	prevSpace := true // 視開頭為空白，使開頭的空白判定為需修改
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if r != ' ' || prevSpace {
+				return false
+			}
+			prevSpace = true
+			continue
+		}
+		if noControl && unicode.IsControl(r) {
+			return false
+		}
+		prevSpace = false
+	}
+	return !prevSpace || s == ""
+}
+
+// truncateForLog 同 TruncateRunes，但 maxLen ≤ 0 時不截斷，且不會留下被切開的 `\n` 跳脫序列。
+func truncateForLog(s string, maxLen int) string {
+	if maxLen <= 0 {
+		return s
+	}
+	cut, ok := runeOffset(s, maxLen)
+	if !ok {
+		return s
+	}
+	cut = clusterSafeCut(s, cut)
+	if cut > 0 && s[cut-1] == '\\' && s[cut] == 'n' {
+		cut--
+	}
+	return s[:cut]
+}
@@ -0,0 +1,122 @@
+package stringx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCollapseWhitespace(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"only_spaces", " \t\n ", ""},
+		{"unchanged", "hello world", "hello world"},
+		{"trim", "  hello  ", "hello"},
+		{"mixed_runs", "hello \t\r\n world", "hello world"},
+		{"single_tab", "a\tb", "a b"},
+		{"unicode_space", "台北　 市", "台北 市"},
+		{"trailing_single", "a ", "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CollapseWhitespace(tt.in); got != tt.want {
+				t.Errorf("CollapseWhitespace(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripControl(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"unchanged", "hello\tworld\n", "hello\tworld\n"},
+		{"c0", "a\x00b\x07c\rd", "abcd"},
+		{"del_and_c1", "a\x7fb\u0085c\u009fd", "abcd"},
+		{"ansi_escape", "\x1b[31mred\x1b[0m", "[31mred[0m"},
+		{"keeps_unicode", "台北\u200d市", "台北\u200d市"},
+		{"invalid_utf8", "a\xffb\x00", "a\xffb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripControl(tt.in); got != tt.want {
+				t.Errorf("StripControl(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripControlExcept(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		allowed []rune
+		want    string
+	}{
+		{"strip_all", "a\tb\nc", nil, "abc"},
+		{"keep_crlf", "a\r\nb\tc", []rune{'\r', '\n'}, "a\r\nbc"},
+		{"keep_tab_only", "a\tb\nc", []rune{'\t'}, "a\tbc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripControlExcept(tt.in, tt.allowed...); got != tt.want {
+				t.Errorf("StripControlExcept(%q, %q) = %q, want %q", tt.in, tt.allowed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeForLog(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		maxLen int
+		want   string
+	}{
+		{"empty", "", 0, ""},
+		{"unchanged", "Mozilla/5.0 (X11; Linux x86_64)", 0, "Mozilla/5.0 (X11; Linux x86_64)"},
+		{"log_injection", "evil\r\nINFO fake entry", 0, `evil\nINFO fake entry`},
+		{"multiple_newlines", "a\n\nb", 0, `a\n\nb`},
+		{"trim_newlines", "\n  value \r\n", 0, "value"},
+		{"collapse", "a \t  b", 0, "a b"},
+		{"space_around_newline", "a  \n  b", 0, `a \n b`},
+		{"strip_control", "\x1b[31mred\x1b[0m\x00", 0, "[31mred[0m"},
+		{"control_inside_space_run", "a \x00 b", 0, "a b"},
+		{"lone_cr", "a\rb", 0, "a b"},
+		{"truncate", "hello world", 5, "hello"},
+		{"truncate_runes", "台北市政府", 2, "台北"},
+		{"truncate_no_split_escape", "ab\ncd", 3, "ab"},
+		{"truncate_after_escape", "ab\ncd", 4, `ab\n`},
+		{"truncate_combining", "cafe\u0301s", 4, "caf"},
+		{"no_limit", strings.Repeat("a", 100), 0, strings.Repeat("a", 100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeForLog(tt.in, tt.maxLen); got != tt.want {
+				t.Errorf("SanitizeForLog(%q, %d) = %q, want %q", tt.in, tt.maxLen, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeNoAllocWhenClean(t *testing.T) {
+	s := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36"
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = CollapseWhitespace(s)
+		_ = StripControl(s)
+		_ = SanitizeForLog(s, 256)
+		_ = SanitizeForLog(s, 10)
+	})
+	if allocs != 0 {
+		t.Errorf("allocs = %v, want 0", allocs)
+	}
+}
@@ -0,0 +1,165 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SplitOptions 設定 SplitAndTrimWithOptions 的行為。
+type SplitOptions struct {
+	// N 限制最多回傳的元素數量，最後一個元素為剩餘的字串（同 strings.SplitN）；
+	// 與 SplitAndTrimN 不同，N <= 0 表示不限制，讓零值即為預設行為。
+	N int
+
+	// Quoted 為 true 時，雙引號內的 sep 不會切分（`a,"b,c",d` → a、b,c、d）。
+	// 以雙引號包住的元素會移除外層引號並保留引號內的空白，引號內以 "" 表示一個 "。
+	Quoted bool
+}
+
+// SplitAndTrim 以 sep 切分 s，移除每個元素前後的空白並捨棄空元素，
+// 取代常見的 strings.Split + TrimSpace + 略過空字串迴圈。
+// s 為空時回傳空的非 nil slice；元素為 s 的子字串，除結果 slice 外不額外配置記憶體。
+//
+//	stringx.SplitAndTrim(" a, b ,,c ", ",") // []string{"a", "b", "c"}
+func SplitAndTrim(s, sep string) []string {
+	return splitAndTrim(s, sep, -1, false)
+}
+
+// SplitAndTrimN 同 SplitAndTrim，但最多回傳 n 個元素（同 strings.SplitN 的語意）：
+//   - n > 0：最多 n 個元素，最後一個元素為未切分的剩餘字串（同樣移除前後空白）
+//   - n == 0：回傳空的非 nil slice
+//   - n < 0：不限制
+//
+// 被捨棄的空元素不計入 n。
+//
+//	stringx.SplitAndTrimN("key = a = b", "=", 2) // []string{"key", "a = b"}
+func SplitAndTrimN(s, sep string, n int) []string {
+	return splitAndTrim(s, sep, n, false)
+}
+
+// SplitAndTrimWithOptions 同 SplitAndTrim，並可設定元素數量上限與是否處理雙引號。
+//
+//	opts := stringx.SplitOptions{Quoted: true}
+//	stringx.SplitAndTrimWithOptions(`a, "b, c", d`, ",", opts) // []string{"a", "b, c", "d"}
+func SplitAndTrimWithOptions(s, sep string, opts SplitOptions) []string {
+	n := opts.N
+	if n <= 0 {
+		n = -1
+	}
+	return splitAndTrim(s, sep, n, opts.Quoted)
+}
+
+// FieldsByComma 以逗號切分 s 並移除空白與空元素，等同 SplitAndTrim(s, ",")。
+//
+//	stringx.FieldsByComma("read, write,") // []string{"read", "write"}
+func FieldsByComma(s string) []string {
+	return splitAndTrim(s, ",", -1, false)
+}
+
+// JoinNonEmpty 以 sep 串接 parts，略過空字串與僅含空白的元素（其餘元素原樣保留，不會移除空白）。
+//
+//	stringx.JoinNonEmpty(", ", "Taipei", "", "  ", "Taiwan") // "Taipei, Taiwan"
+func JoinNonEmpty(sep string, parts ...string) string {
+	var (
+		n     int
+		count int
+	)
+	for _, p := range parts {
+		if !IsEmpty(p) {
+			n += len(p)
+			count++
+		}
+	}
+	if count == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.Grow(n + len(sep)*(count-1))
+	for _, p := range parts {
+		if IsEmpty(p) {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// splitAndTrim 為 SplitAndTrim 系列的共用實作，n 的語意同 SplitAndTrimN。
+func splitAndTrim(s, sep string, n int, quoted bool) []string {
+	if n == 0 || s == "" {
+		return []string{}
+	}
+	if sep == "" {
+		// 同 strings.Split：空 sep 以 UTF-8 字元切分，不需處理引號
+		parts := strings.SplitN(s, "", n)
+		out := make([]string, 0, len(parts))
+		for _, p := range parts {
+			out = appendElem(out, p, false)
+		}
+		return out
+	}
+
+	capacity := strings.Count(s, sep) + 1
+	if n > 0 && n < capacity {
+		capacity = n
+	}
+	out := make([]string, 0, capacity)
+
+	for {
+		if n > 0 && len(out) == n-1 {
+			// 剩餘字串作為最後一個元素；先略過開頭的空元素，讓它們不計入 n
+			for {
+				t := strings.TrimLeftFunc(s, unicode.IsSpace)
+				if !strings.HasPrefix(t, sep) {
+					break
+				}
+				s = t[len(sep):]
+			}
+			return appendElem(out, s, quoted)
+		}
+
+		i := indexSep(s, sep, quoted)
+		if i < 0 {
+			return appendElem(out, s, quoted)
+		}
+		out = appendElem(out, s[:i], quoted)
+		s = s[i+len(sep):]
+	}
+}
+
+// indexSep 回傳 s 中第一個 sep 的位置；quoted 為 true 時略過雙引號內的 sep。
+func indexSep(s, sep string, quoted bool) int {
+	if !quoted {
+		return strings.Index(s, sep)
+	}
+	inQuote := false
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' {
+			inQuote = !inQuote
+			continue
+		}
+		if !inQuote && strings.HasPrefix(s[i:], sep) {
+			return i
+		}
+	}
+	return -1
+}
+
+// appendElem 移除 elem 前後空白（quoted 時再移除外層雙引號），非空時加入 out。
+func appendElem(out []string, elem string, quoted bool) []string {
+	elem = strings.TrimSpace(elem)
+	if quoted && len(elem) >= 2 && elem[0] == '"' && elem[len(elem)-1] == '"' {
+		elem = elem[1 : len(elem)-1]
+		if strings.Contains(elem, `""`) {
+			elem = strings.ReplaceAll(elem, `""`, `"`)
+		}
+	}
+	if elem == "" {
+		return out
+	}
+	return append(out, elem)
+}
@@ -0,0 +1,122 @@
+package stringx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitAndTrim(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		sep  string
+		want []string
+	}{
+		{"empty", "", ",", []string{}},
+		{"only_separators", " , ,, ", ",", []string{}},
+		{"single", "a", ",", []string{"a"}},
+		{"trim_and_drop_empty", " a, b ,,c ", ",", []string{"a", "b", "c"}},
+		{"trailing_separator", "a,b,", ",", []string{"a", "b"}},
+		{"multi_byte_sep", "a :: b::c", "::", []string{"a", "b", "c"}},
+		{"tabs_and_newlines", "a\t,\nb", ",", []string{"a", "b"}},
+		{"unicode", "台北, 東京 ,", ",", []string{"台北", "東京"}},
+		{"quotes_not_special", `a,"b,c"`, ",", []string{"a", `"b`, `c"`}},
+		{"empty_sep_splits_runes", "a b", "", []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitAndTrim(tt.s, tt.sep)
+			if got == nil {
+				t.Fatalf("SplitAndTrim(%q, %q) = nil, want non-nil", tt.s, tt.sep)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitAndTrim(%q, %q) = %q, want %q", tt.s, tt.sep, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitAndTrimN(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		n    int
+		want []string
+	}{
+		{"n_zero", "a,b", 0, []string{}},
+		{"n_negative", "a, b, c", -1, []string{"a", "b", "c"}},
+		{"n_one", " a, b ", 1, []string{"a, b"}},
+		{"n_two", "a, b, c", 2, []string{"a", "b, c"}},
+		{"n_larger_than_parts", "a,b", 5, []string{"a", "b"}},
+		{"empty_not_counted", ",, a,,  , b, c", 2, []string{"a", "b, c"}},
+		{"empty_remainder", "a, ,", 2, []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitAndTrimN(tt.s, ",", tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitAndTrimN(%q, %d) = %q, want %q", tt.s, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitAndTrimWithOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		opts SplitOptions
+		want []string
+	}{
+		{"zero_value", "a, b", SplitOptions{}, []string{"a", "b"}},
+		{"quoted", `a,"b,c",d`, SplitOptions{Quoted: true}, []string{"a", "b,c", "d"}},
+		{"quoted_keeps_inner_space", ` "  b " , c`, SplitOptions{Quoted: true}, []string{"  b ", "c"}},
+		{"escaped_quote", `"say ""hi""",x`, SplitOptions{Quoted: true}, []string{`say "hi"`, "x"}},
+		{"empty_quoted_dropped", `a,"",b`, SplitOptions{Quoted: true}, []string{"a", "b"}},
+		{"unbalanced_quote", `a,"b,c`, SplitOptions{Quoted: true}, []string{"a", `"b,c`}},
+		{"partial_quote", `k="v,w",x`, SplitOptions{Quoted: true}, []string{`k="v,w"`, "x"}},
+		{"quoted_with_n", `"a,b", c, d`, SplitOptions{Quoted: true, N: 2}, []string{"a,b", "c, d"}},
+		{"n_negative_unlimited", "a,b,c", SplitOptions{N: -1}, []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitAndTrimWithOptions(tt.s, ",", tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitAndTrimWithOptions(%q, %+v) = %q, want %q", tt.s, tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldsByComma(t *testing.T) {
+	if got, want := FieldsByComma("read, write,"), []string{"read", "write"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FieldsByComma() = %q, want %q", got, want)
+	}
+	if got := FieldsByComma(""); got == nil || len(got) != 0 {
+		t.Errorf("FieldsByComma(\"\") = %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestJoinNonEmpty(t *testing.T) {
+	tests := []struct {
+		name  string
+		sep   string
+		parts []string
+		want  string
+	}{
+		{"none", ",", nil, ""},
+		{"all_empty", ",", []string{"", " ", "\t"}, ""},
+		{"skip_empty", ", ", []string{"Taipei", "", "  ", "Taiwan"}, "Taipei, Taiwan"},
+		{"keeps_inner_whitespace", "|", []string{" a ", "b"}, " a |b"},
+		{"single", ",", []string{"", "x"}, "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JoinNonEmpty(tt.sep, tt.parts...); got != tt.want {
+				t.Errorf("JoinNonEmpty(%q, %q) = %q, want %q", tt.sep, tt.parts, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,43 +2,6 @@ package stringx
 
 import "strings"
 
-// ToSnake 將字串轉為 snake_case（簡化版）。
-func ToSnake(s string) string {
-	if s == "" {
-		return s
-	}
-
-	var b strings.Builder
-	b.Grow(len(s) * 2)
-
-	lastWasUnderscore := false
-	for i, r := range s {
-		if r >= 'A' && r <= 'Z' {
-			if i > 0 && !lastWasUnderscore {
-				b.WriteByte('_')
-			}
-			r += 'a' - 'A'
-			b.WriteRune(r)
-			lastWasUnderscore = false
-			continue
-		}
-
-		if r == ' ' || r == '-' {
-			if !lastWasUnderscore {
-				b.WriteByte('_')
-				lastWasUnderscore = true
-			}
-			continue
-		}
-
-		b.WriteRune(r)
-		lastWasUnderscore = (r == '_')
-	}
-
-	out := b.String()
-	return strings.TrimRight(out, "_")
-}
-
 // EscapeBackslash 將單反斜線替換為雙反斜線（通用字串處理）。
 func EscapeBackslash(s string) string {
 	return strings.ReplaceAll(s, "\\", "\\\\")
@@ -55,6 +18,8 @@ func IsEmpty(s string) bool {
 }
 
 // Truncate 截斷字串到指定長度（以 byte 計，UTF-8 可能切到半個 rune）。
+// 為相容性保留；處理非 ASCII 字串時請改用 TruncateBytes（不切壞 rune）、
+// TruncateRunes（以字元計）或 TruncateWithEllipsis。
 func Truncate(s string, maxLen int) string {
 	if maxLen <= 0 {
 		return ""
@@ -15,12 +15,21 @@ func TestToSnake(t *testing.T) {
 		{"camelCase", "camelCase", "camel_case"},
 		{"PascalCase", "PascalCase", "pascal_case"},
 		{"already_snake", "already_snake", "already_snake"},
-		// 注意: 目前的 ToSnake 實作是簡化版，遇大寫即切分，不處理連續大寫縮寫或數值邊界
-		{"with_numbers", "User123ID", "user123_i_d"},
-		{"multiple_upper", "HTMLParser", "h_t_m_l_parser"},
+		{"with_numbers", "User123ID", "user123_id"},
+		{"multiple_upper", "HTMLParser", "html_parser"},
 		{"with_space", "Hello World", "hello_world"},
 		{"with_dash", "Hello-World", "hello_world"},
-		{"complex", "ThisIsA_TEST", "this_is_a_t_e_s_t"},
+		{"complex", "ThisIsA_TEST", "this_is_a_test"},
+		{"trailing_acronym", "UserID", "user_id"},
+		{"middle_acronym", "ParseHTTPResponse", "parse_http_response"},
+		{"all_upper", "URL", "url"},
+		{"single_letters", "ABTest", "ab_test"},
+		{"digit_then_lower", "Base64encode", "base64encode"},
+		{"digit_then_upper", "Sha256Sum", "sha256_sum"},
+		{"repeated_separators", "a__b--c  d", "a_b_c_d"},
+		{"trim_separators", "_private_", "private"},
+		{"unicode", "ÜberName", "über_name"},
+		{"non_cased_letters", "使用者Name", "使用者_name"},
 	}
 
 	for _, tt := range tests {
@@ -32,6 +41,37 @@ func TestToSnake(t *testing.T) {
 	}
 }
 
+func TestToSnakeWithOptions(t *testing.T) {
+	acronyms := SnakeOptions{Acronyms: []string{"OAuth", "OAuth2", "iOS"}}
+
+	tests := []struct {
+		name string
+		in   string
+		opts SnakeOptions
+		want string
+	}{
+		{"zero_options", "User123ID", SnakeOptions{}, "user123_id"},
+		{"split_digits", "User123ID", SnakeOptions{SplitDigits: true}, "user_123_id"},
+		{"split_digits_lower", "v2beta", SnakeOptions{SplitDigits: true}, "v_2_beta"},
+		{"no_acronym", "OAuth2Token", SnakeOptions{}, "o_auth2_token"},
+		{"acronym", "OAuth2Token", acronyms, "oauth2_token"},
+		{"acronym_longest", "GetOAuth2Token", acronyms, "get_oauth2_token"},
+		{"acronym_shorter", "OAuthToken", acronyms, "oauth_token"},
+		{"acronym_not_at_word_start", "SupportsiOS", acronyms, "supportsi_os"},
+		{"acronym_after_separator", "supports_iOS", acronyms, "supports_ios"},
+		{"acronym_followed_by_lower", "OAuthorize", acronyms, "o_authorize"},
+		{"acronym_with_split_digits", "OAuth2Token", SnakeOptions{SplitDigits: true, Acronyms: []string{"OAuth2"}}, "oauth2_token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToSnakeWithOptions(tt.in, tt.opts); got != tt.want {
+				t.Errorf("ToSnakeWithOptions(%q, %+v) = %q, want %q", tt.in, tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestEscapeBackslash(t *testing.T) {
 	tests := []struct {
 		name string
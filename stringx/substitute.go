@@ -0,0 +1,144 @@
+package stringx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrInvalidTemplate 表示樣板語法錯誤（例如未結束的 ${ 或 ${} 內的名稱不合法）。
+	ErrInvalidTemplate = errors.New("stringx: invalid template")
+	// ErrUnknownVariable 表示樣板引用了未提供的變數，錯誤訊息會列出所有缺少的名稱。
+	ErrUnknownVariable = errors.New("stringx: unknown template variable")
+)
+
+// Substitute 以 vars 取代樣板中的 ${name} 與 $name，$$ 表示字面的 $：
+//
+//	s, err := stringx.Substitute("reports/${tenant}/${date}/summary.csv", map[string]string{
+//	    "tenant": "acme",
+//	    "date":   "2025-12-19",
+//	}) // "reports/acme/2025-12-19/summary.csv"
+//
+// 名稱由英數字與底線組成且不以數字開頭；$ 後方不是名稱、{ 或 $ 時視為字面的 $（例如 "$5"）。
+// 變數值會原樣插入、不會再次展開，值中的 ${other} 維持字面文字，避免注入。
+//
+// 引用未提供的變數時回傳包裝 ErrUnknownVariable 的錯誤，並一次列出所有缺少的名稱（依出現順序）；
+// 語法錯誤時回傳包裝 ErrInvalidTemplate 的錯誤。
+func Substitute(tmpl string, vars map[string]string) (string, error) {
+	return SubstituteFunc(tmpl, mapLookup(vars))
+}
+
+// SubstituteLoose 同 Substitute，但未提供的變數與語法錯誤的片段會原樣保留而不回傳錯誤：
+//
+//	stringx.SubstituteLoose("Hi ${name}, ${missing}", map[string]string{"name": "Ann"}) // "Hi Ann, ${missing}"
+func SubstituteLoose(tmpl string, vars map[string]string) string {
+	s, _ := substitute(tmpl, mapLookup(vars), false)
+	return s
+}
+
+// SubstituteFunc 同 Substitute，但以 lookup 取得變數值（例如 os.LookupEnv），lookup 回傳 false 表示變數不存在。
+func SubstituteFunc(tmpl string, lookup func(string) (string, bool)) (string, error) {
+	return substitute(tmpl, lookup, true)
+}
+
+// mapLookup 將 map 轉為 SubstituteFunc 使用的 lookup 函式。
+func mapLookup(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}
+}
+
+// substitute 展開樣板；strict 為 false 時保留無法展開的片段並一律回傳 nil 錯誤。
+func substitute(tmpl string, lookup func(string) (string, bool), strict bool) (string, error) {
+	i := strings.IndexByte(tmpl, '$')
+	if i < 0 {
+		return tmpl, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(tmpl))
+	var missing []string
+	for i >= 0 {
+		b.WriteString(tmpl[:i])
+		rest := tmpl[i+1:]
+
+		var name, raw string // raw 為整個佔位符（含 $），無法展開時原樣寫回
+		switch {
+		case strings.HasPrefix(rest, "$"):
+			b.WriteByte('$')
+			tmpl = rest[1:]
+			i = strings.IndexByte(tmpl, '$')
+			continue
+		case strings.HasPrefix(rest, "{"):
+			end := strings.IndexByte(rest, '}')
+			if end < 0 {
+				if strict {
+					return "", fmt.Errorf("%w: unterminated ${ in %q", ErrInvalidTemplate, tmpl[i:])
+				}
+				b.WriteString(tmpl[i:])
+				return b.String(), nil
+			}
+			name, raw = rest[1:end], tmpl[i:i+end+2]
+			if !isVarName(name) {
+				if strict {
+					return "", fmt.Errorf("%w: invalid variable name %q", ErrInvalidTemplate, name)
+				}
+				name = "" // 原樣保留
+			}
+		default:
+			n := varNameLen(rest)
+			if n == 0 {
+				b.WriteByte('$') // 字面的 $
+				tmpl = rest
+				i = strings.IndexByte(tmpl, '$')
+				continue
+			}
+			name, raw = rest[:n], tmpl[i:i+n+1]
+		}
+
+		switch v, ok := lookupName(lookup, name); {
+		case ok:
+			b.WriteString(v)
+		case name != "":
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			fallthrough
+		default:
+			b.WriteString(raw)
+		}
+		tmpl = tmpl[i+len(raw):]
+		i = strings.IndexByte(tmpl, '$')
+	}
+	b.WriteString(tmpl)
+
+	if strict && len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrUnknownVariable, strings.Join(missing, ", "))
+	}
+	return b.String(), nil
+}
+
+// lookupName 呼叫 lookup；name 為空（語法錯誤而保留原文的片段）時不呼叫並回傳 false。
+func lookupName(lookup func(string) (string, bool), name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+	return lookup(name)
+}
+
+// varNameLen 回傳 s 開頭的變數名稱長度（英數字與底線，不以數字開頭），不是名稱時回傳 0。
+func varNameLen(s string) int {
+	n := 0
+	for n < len(s) && (s[n] == '_' || isASCIILetter(s[n]) || n > 0 && isASCIIDigit(s[n])) {
+		n++
+	}
+	return n
+}
+
+// isVarName 回傳 s 是否為合法的變數名稱。
+func isVarName(s string) bool {
+	return s != "" && varNameLen(s) == len(s)
+}
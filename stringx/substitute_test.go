@@ -0,0 +1,98 @@
+package stringx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSubstitute(t *testing.T) {
+	vars := map[string]string{
+		"tenant": "acme",
+		"date":   "2025-12-19",
+		"price":  "100",
+		"empty":  "",
+		"evil":   "${tenant}",
+		"_x1":    "u",
+	}
+
+	tests := []struct {
+		name  string
+		tmpl  string
+		want  string
+		loose string // SubstituteLoose 的結果，空字串表示與 want 相同
+		err   error
+	}{
+		{"no_placeholders", "plain text", "plain text", "", nil},
+		{"braced", "reports/${tenant}/${date}/summary.csv", "reports/acme/2025-12-19/summary.csv", "", nil},
+		{"bare", "$tenant-$date", "acme-2025-12-19", "", nil},
+		{"bare_stops_at_non_name", "$tenant.csv", "acme.csv", "", nil},
+		{"adjacent", "${tenant}${date}", "acme2025-12-19", "", nil},
+		{"dollar_escape", "cost: $$${price}", "cost: $100", "", nil},
+		{"double_escape", "$$$$", "$$", "", nil},
+		{"literal_dollar", "$5 and $ and end$", "$5 and $ and end$", "", nil},
+		{"empty_value", "[${empty}]", "[]", "", nil},
+		{"underscore_name", "${_x1}$_x1", "uu", "", nil},
+		{"no_recursive_expansion", "v=${evil}", "v=${tenant}", "", nil},
+		{"missing", "${tenant}/${region}", "", "acme/${region}", ErrUnknownVariable},
+		{"missing_bare", "$region-$zone", "", "$region-$zone", ErrUnknownVariable},
+		{"unterminated", "a ${tenant", "", "a ${tenant", ErrInvalidTemplate},
+		{"invalid_name", "a ${ten ant} ${tenant}", "", "a ${ten ant} acme", ErrInvalidTemplate},
+		{"empty_name", "a ${}", "", "a ${}", ErrInvalidTemplate},
+		{"unicode", "台北 ${tenant} 市", "台北 acme 市", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Substitute(tt.tmpl, vars)
+			if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+				t.Fatalf("Substitute(%q) error = %v, want %v", tt.tmpl, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("Substitute(%q) = %q, want %q", tt.tmpl, got, tt.want)
+			}
+
+			wantLoose := tt.loose
+			if wantLoose == "" {
+				wantLoose = tt.want
+			}
+			if got := SubstituteLoose(tt.tmpl, vars); got != wantLoose {
+				t.Errorf("SubstituteLoose(%q) = %q, want %q", tt.tmpl, got, wantLoose)
+			}
+		})
+	}
+}
+
+func TestSubstituteCollectsMissing(t *testing.T) {
+	_, err := Substitute("${a}/${b}/$a/${c}", map[string]string{"b": "x"})
+	if !errors.Is(err, ErrUnknownVariable) {
+		t.Fatalf("error = %v, want ErrUnknownVariable", err)
+	}
+	if !strings.HasSuffix(err.Error(), ": a, c") {
+		t.Errorf("error = %q, want all missing names once in order", err)
+	}
+}
+
+func TestSubstituteFunc(t *testing.T) {
+	var calls []string
+	lookup := func(name string) (string, bool) {
+		calls = append(calls, name)
+		if name == "HOME" {
+			return "/home/app", true
+		}
+		return "", false
+	}
+
+	got, err := SubstituteFunc("$HOME/.config", lookup)
+	if err != nil || got != "/home/app/.config" {
+		t.Fatalf("SubstituteFunc() = %q, %v", got, err)
+	}
+
+	calls = nil
+	if _, err := SubstituteFunc("${HOME} ${USER}", lookup); !errors.Is(err, ErrUnknownVariable) {
+		t.Errorf("error = %v, want ErrUnknownVariable", err)
+	}
+	if strings.Join(calls, ",") != "HOME,USER" {
+		t.Errorf("lookup calls = %q", calls)
+	}
+}
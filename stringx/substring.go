@@ -0,0 +1,121 @@
+package stringx
+
+import "strings"
+
+// Between 回傳第一個 start 與其後第一個 end 之間的子字串（不含標記）：
+//
+//	stringx.Between("user=[alice] id=[42]", "[", "]") // "alice", true
+//
+// 找不到任一標記或標記為空字串時回傳 "", false（而非整個字串）。
+// 標記巢狀時不做括號配對，取第一個 start 之後最近的 end：
+//
+//	stringx.Between("f(g(x))", "(", ")") // "g(x", true
+func Between(s, start, end string) (string, bool) {
+	v, _, ok := between(s, start, end)
+	return v, ok
+}
+
+// BetweenAll 回傳所有不重疊的 start…end 之間的子字串，每次比對自上一個 end 之後繼續（規則同 Between）：
+//
+//	stringx.BetweenAll("[a] [b] [c", "[", "]") // []string{"a", "b"}
+//
+// 沒有任何符合時回傳空的非 nil slice。
+func BetweenAll(s, start, end string) []string {
+	res := []string{}
+	for {
+		v, next, ok := between(s, start, end)
+		if !ok {
+			return res
+		}
+		res = append(res, v)
+		s = s[next:]
+	}
+}
+
+// SubstringAfter 回傳第一個 sep 之後的子字串；找不到 sep 或 sep 為空時回傳 "", false：
+//
+//	stringx.SubstringAfter("a.b.c", ".") // "b.c", true
+func SubstringAfter(s, sep string) (string, bool) {
+	if sep == "" {
+		return "", false
+	}
+	_, after, ok := strings.Cut(s, sep)
+	return after, ok
+}
+
+// SubstringAfterLast 回傳最後一個 sep 之後的子字串（例如副檔名、URL 最後一段），規則同 SubstringAfter：
+//
+//	stringx.SubstringAfterLast("a.b.c", ".") // "c", true
+func SubstringAfterLast(s, sep string) (string, bool) {
+	if sep == "" {
+		return "", false
+	}
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return "", false
+	}
+	return s[i+len(sep):], true
+}
+
+// SubstringBefore 回傳第一個 sep 之前的子字串，規則同 SubstringAfter：
+//
+//	stringx.SubstringBefore("a.b.c", ".") // "a", true
+func SubstringBefore(s, sep string) (string, bool) {
+	if sep == "" {
+		return "", false
+	}
+	before, _, ok := strings.Cut(s, sep)
+	if !ok {
+		return "", false
+	}
+	return before, true
+}
+
+// SubstringBeforeLast 回傳最後一個 sep 之前的子字串，規則同 SubstringAfter：
+//
+//	stringx.SubstringBeforeLast("a.b.c", ".") // "a.b", true
+func SubstringBeforeLast(s, sep string) (string, bool) {
+	if sep == "" {
+		return "", false
+	}
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return "", false
+	}
+	return s[:i], true
+}
+
+// SafeSubstring 以 rune 索引回傳 [from, to) 的子字串，超出範圍的索引會被限制在 [0, rune 數] 內而不會 panic；
+// from ≥ to 時回傳空字串：
+//
+//	stringx.SafeSubstring("hello世界", 5, 100) // "世界"
+//	stringx.SafeSubstring("hello", -3, 2)    // "he"
+func SafeSubstring(s string, from, to int) string {
+	from = max(from, 0)
+	if from >= to {
+		return ""
+	}
+	start, ok := runeOffset(s, from)
+	if !ok {
+		return ""
+	}
+	end, _ := runeOffset(s[start:], to-from)
+	return s[start : start+end]
+}
+
+// between 回傳第一個 start…end 之間的子字串，以及 end 之後的 byte 位置。
+func between(s, start, end string) (string, int, bool) {
+	if start == "" || end == "" {
+		return "", 0, false
+	}
+	i := strings.Index(s, start)
+	if i < 0 {
+		return "", 0, false
+	}
+	i += len(start)
+	j := strings.Index(s[i:], end)
+	if j < 0 {
+		return "", 0, false
+	}
+	return s[i : i+j], i + j + len(end), true
+}
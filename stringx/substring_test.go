@@ -0,0 +1,126 @@
+package stringx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		start, end string
+		want       string
+		wantOK     bool
+	}{
+		{"basic", "user=[alice] id=[42]", "[", "]", "alice", true},
+		{"multi_char_markers", "<<a>> <<b>>", "<<", ">>", "a", true},
+		{"empty_value", "x[]y", "[", "]", "", true},
+		{"missing_start", "alice]", "[", "]", "", false},
+		{"missing_end", "[alice", "[", "]", "", false},
+		{"end_before_start", "]a[", "[", "]", "", false},
+		{"nested_first_match", "f(g(x))", "(", ")", "g(x", true},
+		{"same_marker", `say "hi" and "bye"`, `"`, `"`, "hi", true},
+		{"empty_start", "abc", "", "c", "", false},
+		{"empty_end", "abc", "a", "", "", false},
+		{"unicode", "「台北」與「高雄」", "「", "」", "台北", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Between(tt.s, tt.start, tt.end)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Between(%q, %q, %q) = %q, %v, want %q, %v", tt.s, tt.start, tt.end, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestBetweenAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		start, end string
+		want       []string
+	}{
+		{"all", "[a] [b] [c]", "[", "]", []string{"a", "b", "c"}},
+		{"unterminated_tail", "[a] [b] [c", "[", "]", []string{"a", "b"}},
+		{"none", "abc", "[", "]", []string{}},
+		{"nested_non_overlapping", "[[a]] [b]", "[", "]", []string{"[a", "b"}},
+		{"same_marker", `"a" x "b"`, `"`, `"`, []string{"a", "b"}},
+		{"empty_marker", "abc", "", "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BetweenAll(tt.s, tt.start, tt.end)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BetweenAll(%q, %q, %q) = %q, want %q", tt.s, tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstringAfterBefore(t *testing.T) {
+	tests := []struct {
+		name            string
+		s, sep          string
+		after, afterL   string
+		before, beforeL string
+		ok              bool
+	}{
+		{"multiple", "a.b.c", ".", "b.c", "c", "a", "a.b", true},
+		{"single", "key=value", "=", "value", "value", "key", "key", true},
+		{"at_edges", ".a.", ".", "a.", "", "", ".a", true},
+		{"multi_char", "a::b::c", "::", "b::c", "c", "a", "a::b", true},
+		{"missing", "abc", ".", "", "", "", "", false},
+		{"empty_sep", "abc", "", "", "", "", "", false},
+		{"empty_s", "", ".", "", "", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := SubstringAfter(tt.s, tt.sep); got != tt.after || ok != tt.ok {
+				t.Errorf("SubstringAfter(%q, %q) = %q, %v, want %q, %v", tt.s, tt.sep, got, ok, tt.after, tt.ok)
+			}
+			if got, ok := SubstringAfterLast(tt.s, tt.sep); got != tt.afterL || ok != tt.ok {
+				t.Errorf("SubstringAfterLast(%q, %q) = %q, %v, want %q, %v", tt.s, tt.sep, got, ok, tt.afterL, tt.ok)
+			}
+			if got, ok := SubstringBefore(tt.s, tt.sep); got != tt.before || ok != tt.ok {
+				t.Errorf("SubstringBefore(%q, %q) = %q, %v, want %q, %v", tt.s, tt.sep, got, ok, tt.before, tt.ok)
+			}
+			if got, ok := SubstringBeforeLast(tt.s, tt.sep); got != tt.beforeL || ok != tt.ok {
+				t.Errorf("SubstringBeforeLast(%q, %q) = %q, %v, want %q, %v", tt.s, tt.sep, got, ok, tt.beforeL, tt.ok)
+			}
+		})
+	}
+}
+
+func TestSafeSubstring(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		from, to int
+		want     string
+	}{
+		{"basic", "hello", 1, 3, "el"},
+		{"full", "hello", 0, 5, "hello"},
+		{"to_out_of_range", "hello世界", 5, 100, "世界"},
+		{"negative_from", "hello", -3, 2, "he"},
+		{"from_out_of_range", "hello", 10, 20, ""},
+		{"from_at_end", "hello", 5, 6, ""},
+		{"from_equals_to", "hello", 2, 2, ""},
+		{"reversed", "hello", 3, 1, ""},
+		{"negative_both", "hello", -5, -1, ""},
+		{"runes", "台北市政府", 1, 3, "北市"},
+		{"empty", "", 0, 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SafeSubstring(tt.s, tt.from, tt.to); got != tt.want {
+				t.Errorf("SafeSubstring(%q, %d, %d) = %q, want %q", tt.s, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultTitleSmallWords 為英文標題（headline style）中通常維持小寫的虛詞，
+// 可作為 TitleCaseOptions.SmallWords 使用。
+var DefaultTitleSmallWords = []string{
+	"a", "an", "and", "as", "at", "but", "by", "for", "in", "nor", "of", "on", "or", "the", "to", "via",
+}
+
+// TitleCaseOptions 設定 TitleCaseWithOptions 的行為。
+type TitleCaseOptions struct {
+	// SmallWords 為維持小寫的單字（不區分大小寫比對），但第一個與最後一個單字仍會大寫，
+	// 例如 DefaultTitleSmallWords：「the lord of the rings」→「The Lord of the Rings」。
+	SmallWords []string
+}
+
+// Capitalize 將第一個字元轉為首字大寫（unicode.ToTitle），其餘字元不變，可正確處理非 ASCII 字元：
+//
+//	stringx.Capitalize("élan") // "Élan"
+//	stringx.Capitalize("iOS")  // "IOS"
+func Capitalize(s string) string {
+	return mapFirstRune(s, unicode.ToTitle)
+}
+
+// Uncapitalize 將第一個字元轉為小寫，其餘字元不變：
+//
+//	stringx.Uncapitalize("UserName") // "userName"
+func Uncapitalize(s string) string {
+	return mapFirstRune(s, unicode.ToLower)
+}
+
+// TitleCase 將每個以空白分隔的單字的第一個字母轉為大寫，其餘字元與空白皆保持原樣，
+// 取代已棄用的 strings.Title。單字開頭的標點會略過（"(note)" → "(Note)"）。
+//
+//	stringx.TitleCase("hello wide  world") // "Hello Wide  World"
+func TitleCase(s string) string {
+	return TitleCaseWithOptions(s, TitleCaseOptions{})
+}
+
+// TitleCaseWithOptions 同 TitleCase，並可指定維持小寫的虛詞（headline style）：
+//
+//	opts := stringx.TitleCaseOptions{SmallWords: stringx.DefaultTitleSmallWords}
+//	stringx.TitleCaseWithOptions("the lord of the rings", opts) // "The Lord of the Rings"
+func TitleCaseWithOptions(s string, opts TitleCaseOptions) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	first := true
+	for len(s) > 0 {
+		// 複製單字前的空白
+		ws := len(s) - len(strings.TrimLeftFunc(s, unicode.IsSpace))
+		b.WriteString(s[:ws])
+		s = s[ws:]
+		if s == "" {
+			break
+		}
+
+		end := strings.IndexFunc(s, unicode.IsSpace)
+		if end < 0 {
+			end = len(s)
+		}
+		word := s[:end]
+		s = s[end:]
+		last := strings.TrimSpace(s) == ""
+
+		if !first && !last && isSmallWord(word, opts.SmallWords) {
+			b.WriteString(strings.ToLower(word))
+		} else {
+			b.WriteString(capitalizeFirstLetter(word))
+		}
+		first = false
+	}
+	return b.String()
+}
+
+// TitleCaseIdentifier 將程式識別字轉為以空白分隔、每個單字首字母大寫的標籤（自動產生的表單標籤），
+// 單字切分規則同 ToSnake：
+//
+//	stringx.TitleCaseIdentifier("user_id")    // "User Id"
+//	stringx.TitleCaseIdentifier("HTMLParser") // "Html Parser"
+func TitleCaseIdentifier(s string) string {
+	words := splitWords(s, SnakeOptions{})
+	for i, w := range words {
+		words[i] = capitalize(w)
+	}
+	return strings.Join(words, " ")
+}
+
+// mapFirstRune 以 f 轉換 s 的第一個字元。
+func mapFirstRune(s string, f func(rune) rune) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 || r == utf8.RuneError {
+		return s
+	}
+	mapped := f(r)
+	if mapped == r {
+		return s
+	}
+	return string(mapped) + s[size:]
+}
+
+// capitalizeFirstLetter 將 word 中第一個字母轉為首字大寫，之前的標點與其後的字元不變。
+func capitalizeFirstLetter(word string) string {
+	i := strings.IndexFunc(word, unicode.IsLetter)
+	if i < 0 {
+		return word
+	}
+	return word[:i] + Capitalize(word[i:])
+}
+
+// isSmallWord 判斷 word 是否屬於 smallWords（不區分大小寫）。
+func isSmallWord(word string, smallWords []string) bool {
+	for _, w := range smallWords {
+		if strings.EqualFold(word, w) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package stringx
+
+import "testing"
+
+func TestCapitalize(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantLow string
+	}{
+		{"", "", ""},
+		{"hello", "Hello", "hello"},
+		{"Hello", "Hello", "hello"},
+		{"iOS", "IOS", "iOS"},
+		{"UserName", "UserName", "userName"},
+		{"élan", "Élan", "élan"},
+		{"ǆungla", "ǅungla", "ǆungla"},
+		{"世界", "世界", "世界"},
+		{"1st", "1st", "1st"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := Capitalize(tt.in); got != tt.want {
+				t.Errorf("Capitalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			if got := Uncapitalize(tt.in); got != tt.wantLow {
+				t.Errorf("Uncapitalize(%q) = %q, want %q", tt.in, got, tt.wantLow)
+			}
+		})
+	}
+}
+
+func TestTitleCase(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"simple", "hello world", "Hello World"},
+		{"keeps_whitespace", "  hello\twide  world ", "  Hello\tWide  World "},
+		{"keeps_rest", "iPhone and macOS", "IPhone And MacOS"},
+		{"punctuation", "(note) \"quoted\"", "(Note) \"Quoted\""},
+		{"apostrophe", "don't stop", "Don't Stop"},
+		{"unicode", "élan vital", "Élan Vital"},
+		{"no_letters", "123 456", "123 456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TitleCase(tt.in); got != tt.want {
+				t.Errorf("TitleCase(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTitleCaseWithOptions_SmallWords(t *testing.T) {
+	opts := TitleCaseOptions{SmallWords: DefaultTitleSmallWords}
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"headline", "the lord of the rings", "The Lord of the Rings"},
+		{"small_words_lowercased", "War AND Peace", "War and Peace"},
+		{"last_word_capitalized", "what is it for", "What Is It For"},
+		{"single_small_word", "the", "The"},
+		{"trailing_space_last_word", "a tale of ", "A Tale Of "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TitleCaseWithOptions(tt.in, opts); got != tt.want {
+				t.Errorf("TitleCaseWithOptions(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTitleCaseIdentifier(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"user_id", "User Id"},
+		{"firstName", "First Name"},
+		{"HTMLParser", "Html Parser"},
+		{"created-at", "Created At"},
+		{"USER_EMAIL", "User Email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := TitleCaseIdentifier(tt.in); got != tt.want {
+				t.Errorf("TitleCaseIdentifier(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// defaultWordWindow 為 TruncateOptions.KeepWords 往回尋找空白的預設範圍（rune 數）。
+const defaultWordWindow = 10
+
+// zeroWidthJoiner 為 emoji 組合序列（如 👨‍👩‍👧）使用的 ZWJ。
+const zeroWidthJoiner = '\u200d'
+
+// TruncateOptions 為 TruncateWithOptions 的選項。
+type TruncateOptions struct {
+	// Ellipsis 為截斷時附加的省略符號（例如 "…" 或 "..."），計入長度上限。
+	Ellipsis string
+	// KeepWords 為 true 時避免在單字中間截斷：往回尋找 WordWindow 範圍內最後一個空白，
+	// 找不到時仍在原位置截斷。適用於 UI 預覽文字。
+	KeepWords bool
+	// WordWindow 為 KeepWords 往回尋找空白的範圍（rune 數），≤ 0 時使用 10。
+	WordWindow int
+}
+
+// TruncateRunes 將字串截斷至最多 maxRunes 個 rune，不會切壞 UTF-8。
+// 為避免破壞字元顯示，不會把組合字元（如重音符號、emoji 變體選擇符）或 ZWJ 序列與前一個字元分開，
+// 必要時會少保留幾個 rune；此處理為簡化版，並非完整的 Unicode grapheme cluster（UAX #29）切分。
+func TruncateRunes(s string, maxRunes int) string {
+	if maxRunes <= 0 {
+		return ""
+	}
+	cut, ok := runeOffset(s, maxRunes)
+	if !ok {
+		return s
+	}
+	return s[:clusterSafeCut(s, cut)]
+}
+
+// TruncateBytes 將字串截斷至最多 maxBytes 個 byte，且不會切在 rune 中間（往前退到上一個字元邊界）。
+// 組合字元與 ZWJ 序列的處理同 TruncateRunes。適用於有 byte 長度限制的欄位（例如 DB VARCHAR、HTTP header）。
+func TruncateBytes(s string, maxBytes int) string {
+	if maxBytes <= 0 {
+		return ""
+	}
+	if len(s) <= maxBytes {
+		return s
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:clusterSafeCut(s, cut)]
+}
+
+// TruncateWithEllipsis 將字串截斷至最多 max 個 rune（含 ellipsis），超過時以 ellipsis 結尾：
+//
+//	stringx.TruncateWithEllipsis("hello world", 8, "...") // "hello..."
+//
+// ellipsis 本身超過 max 時回傳截斷後的 ellipsis。
+func TruncateWithEllipsis(s string, max int, ellipsis string) string {
+	return TruncateWithOptions(s, max, TruncateOptions{Ellipsis: ellipsis})
+}
+
+// TruncateWithOptions 將字串截斷至最多 maxRunes 個 rune（含 opts.Ellipsis），
+// 並可依 opts.KeepWords 避免在單字中間截斷；截斷處前方的空白會被移除。
+//
+//	opts := stringx.TruncateOptions{Ellipsis: "…", KeepWords: true}
+//	stringx.TruncateWithOptions("The quick brown fox", 13, opts) // "The quick…"
+func TruncateWithOptions(s string, maxRunes int, opts TruncateOptions) string {
+	if maxRunes <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+
+	budget := maxRunes - utf8.RuneCountInString(opts.Ellipsis)
+	if budget <= 0 {
+		return TruncateRunes(opts.Ellipsis, maxRunes)
+	}
+
+	cut, _ := runeOffset(s, budget)
+	cut = clusterSafeCut(s, cut)
+	if opts.KeepWords {
+		cut = wordSafeCut(s, cut, opts.WordWindow)
+	}
+	return strings.TrimRightFunc(s[:cut], unicode.IsSpace) + opts.Ellipsis
+}
+
+// runeOffset 回傳第 n 個 rune 的 byte 位置；字串不足 n 個 rune 時回傳 false。
+func runeOffset(s string, n int) (int, bool) {
+	count := 0
+	for i := range s {
+		if count == n {
+			return i, true
+		}
+		count++
+	}
+	return len(s), false
+}
+
+// clusterSafeCut 將截斷位置 cut（需為 rune 起點）往前移，避免拆開組合字元或 ZWJ 序列。
+func clusterSafeCut(s string, cut int) int {
+	for cut > 0 && cut < len(s) {
+		r, _ := utf8.DecodeRuneInString(s[cut:])
+		prev, size := utf8.DecodeLastRuneInString(s[:cut])
+		if !unicode.Is(unicode.M, r) && r != zeroWidthJoiner && prev != zeroWidthJoiner {
+			break
+		}
+		cut -= size
+	}
+	return cut
+}
+
+// wordSafeCut 在 cut 落在單字中間時，往前 window 個 rune 內尋找空白作為截斷位置。
+func wordSafeCut(s string, cut, window int) int {
+	if cut == 0 || cut >= len(s) {
+		return cut
+	}
+	next, _ := utf8.DecodeRuneInString(s[cut:])
+	prev, _ := utf8.DecodeLastRuneInString(s[:cut])
+	if unicode.IsSpace(next) || unicode.IsSpace(prev) {
+		return cut // 已在單字邊界
+	}
+
+	if window <= 0 {
+		window = defaultWordWindow
+	}
+	for i := cut; i > 0 && window > 0; window-- {
+		r, size := utf8.DecodeLastRuneInString(s[:i])
+		i -= size
+		if unicode.IsSpace(r) {
+			if i == 0 {
+				break // 只剩空白時不退
+			}
+			return i
+		}
+	}
+	return cut
+}
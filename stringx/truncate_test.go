@@ -0,0 +1,131 @@
+package stringx
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		maxRunes int
+		want     string
+	}{
+		{"negative", "hello", -1, ""},
+		{"zero", "hello", 0, ""},
+		{"shorter", "hello", 10, "hello"},
+		{"exact", "hello", 5, "hello"},
+		{"ascii", "hello world", 5, "hello"},
+		{"cjk", "hello世界你好", 7, "hello世界"},
+		{"emoji", "a😀b😀", 2, "a😀"},
+		// e + U+0301（組合重音）不會被拆開
+		{"combining_mark", "cafe\u0301s", 4, "caf"},
+		{"combining_mark_kept", "cafe\u0301s", 5, "cafe\u0301"},
+		// 👍 + U+FE0F 變體選擇符
+		{"variation_selector", "ok👍\ufe0f!", 3, "ok"},
+		// 👨 + ZWJ + 👩 的組合序列不拆開
+		{"zwj_sequence", "hi👨\u200d👩!", 4, "hi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateRunes(tt.in, tt.maxRunes)
+			if got != tt.want {
+				t.Errorf("TruncateRunes(%q, %d) = %q, want %q", tt.in, tt.maxRunes, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("TruncateRunes(%q, %d) returned invalid UTF-8", tt.in, tt.maxRunes)
+			}
+		})
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	s := "hello世界" // hello(5) + 世界(6) = 11 bytes
+	tests := []struct {
+		name     string
+		in       string
+		maxBytes int
+		want     string
+	}{
+		{"zero", s, 0, ""},
+		{"full", s, 20, s},
+		{"exact", s, 11, s},
+		{"ascii", s, 5, "hello"},
+		{"back_off_1", s, 6, "hello"},
+		{"back_off_2", s, 7, "hello"},
+		{"rune_boundary", s, 8, "hello世"},
+		{"back_off_to_empty", "世界", 2, ""},
+		{"combining_mark", "cafe\u0301", 5, "caf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateBytes(tt.in, tt.maxBytes)
+			if got != tt.want {
+				t.Errorf("TruncateBytes(%q, %d) = %q, want %q", tt.in, tt.maxBytes, got, tt.want)
+			}
+			if len(got) > max(tt.maxBytes, 0) || !utf8.ValidString(got) {
+				t.Errorf("TruncateBytes(%q, %d) = %q exceeds limit or is invalid UTF-8", tt.in, tt.maxBytes, got)
+			}
+		})
+	}
+}
+
+func TestTruncateWithEllipsis(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		max      int
+		ellipsis string
+		want     string
+	}{
+		{"no_truncate", "hello", 5, "...", "hello"},
+		{"ascii", "hello world", 8, "...", "hello..."},
+		{"unicode_ellipsis", "hello world", 6, "…", "hello…"},
+		{"cjk", "這是一段很長的中文說明", 6, "…", "這是一段很…"},
+		{"trim_space_before_ellipsis", "hello world", 9, "...", "hello..."},
+		{"ellipsis_too_long", "hello world", 2, "...", ".."},
+		{"empty_ellipsis", "hello world", 5, "", "hello"},
+		{"zero", "hello", 0, "...", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateWithEllipsis(tt.in, tt.max, tt.ellipsis)
+			if got != tt.want {
+				t.Errorf("TruncateWithEllipsis(%q, %d, %q) = %q, want %q", tt.in, tt.max, tt.ellipsis, got, tt.want)
+			}
+			if n := utf8.RuneCountInString(got); n > max(tt.max, 0) {
+				t.Errorf("result has %d runes, want <= %d", n, tt.max)
+			}
+		})
+	}
+}
+
+func TestTruncateWithOptions_KeepWords(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		max  int
+		opts TruncateOptions
+		want string
+	}{
+		{"back_off_to_space", "The quick brown fox", 13, TruncateOptions{Ellipsis: "…", KeepWords: true}, "The quick…"},
+		{"without_keep_words", "The quick brown fox", 13, TruncateOptions{Ellipsis: "…"}, "The quick br…"},
+		{"already_on_boundary", "The quick brown fox", 10, TruncateOptions{Ellipsis: "…", KeepWords: true}, "The quick…"},
+		{"no_space_in_window", "Supercalifragilistic word", 12, TruncateOptions{Ellipsis: "…", KeepWords: true, WordWindow: 3}, "Supercalifr…"},
+		{"window_limits_back_off", "ab cdefghijklmnop", 12, TruncateOptions{Ellipsis: "...", KeepWords: true, WordWindow: 5}, "ab cdefgh..."},
+		{"wide_window", "ab cdefghijklmnop", 12, TruncateOptions{Ellipsis: "...", KeepWords: true, WordWindow: 20}, "ab..."},
+		{"leading_space_only", " abcdefghij", 6, TruncateOptions{Ellipsis: ".", KeepWords: true}, " abcd."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TruncateWithOptions(tt.in, tt.max, tt.opts); got != tt.want {
+				t.Errorf("TruncateWithOptions(%q, %d, %+v) = %q, want %q", tt.in, tt.max, tt.opts, got, tt.want)
+			}
+		})
+	}
+}
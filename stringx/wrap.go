@@ -0,0 +1,293 @@
+package stringx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WrapOptions 設定 WrapWithOptions 的行為。
+type WrapOptions struct {
+	// EastAsianWidth 為 true 時，東亞全形字元（CJK、全形標點、部分 emoji）以 2 欄計算、
+	// 組合字元與 ZWJ 以 0 欄計算（同 VisualWidth），適合終端機輸出；預設每個 rune 皆為 1 欄。
+	EastAsianWidth bool
+
+	// Newline 為空時保留每一行原本的換行符號（\n 或 \r\n），新插入的換行沿用該行的換行符號
+	// （最後一行沒有換行時沿用輸入中第一個換行符號，都沒有時為 \n）；
+	// 非空時所有換行（原有與新插入）皆正規化為 Newline。
+	Newline string
+}
+
+// Wrap 將每一行在單字邊界折行，使每行不超過 width 個 rune；單字本身超過 width 時才會從中切斷。
+// 行內連續空白會折疊為一個空白，行首縮排保留於該行折行後的第一行，空行保留（段落分隔）。
+// 換行符號的處理見 WrapOptions.Newline；width <= 0 時回傳原字串。
+//
+//	stringx.Wrap("The quick brown fox jumps", 10)
+//	// "The quick\nbrown fox\njumps"
+func Wrap(s string, width int) string {
+	return WrapWithOptions(s, width, WrapOptions{})
+}
+
+// WrapWithOptions 同 Wrap，並可設定東亞字寬與換行符號。
+func WrapWithOptions(s string, width int, opts WrapOptions) string {
+	if width <= 0 {
+		return s
+	}
+
+	defaultEOL := opts.Newline
+	if defaultEOL == "" {
+		defaultEOL = firstNewline(s)
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) + len(s)/width + 1)
+	for _, ln := range splitLines(s) {
+		eol := ln.eol
+		if opts.Newline != "" && eol != "" {
+			eol = opts.Newline
+		}
+		breakEOL := eol
+		if breakEOL == "" {
+			breakEOL = defaultEOL
+		}
+		wrapLine(&b, ln.content, width, breakEOL, opts.EastAsianWidth)
+		b.WriteString(eol)
+	}
+	return b.String()
+}
+
+// Indent 在每一行前加上 prefix（包含空行），但不會在結尾換行之後多加一行 prefix：
+// "a\n\nb\n" → "> a\n> \n> b\n"。換行符號（\n 或 \r\n）保持原樣；s 為空時回傳空字串。
+func Indent(s, prefix string) string {
+	if s == "" || prefix == "" {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) + len(prefix)*(strings.Count(s, "\n")+1))
+	for _, ln := range splitLines(s) {
+		b.WriteString(prefix)
+		b.WriteString(ln.content)
+		b.WriteString(ln.eol)
+	}
+	return b.String()
+}
+
+// Dedent 移除所有非空白行共同的行首空白（同 Python textwrap.dedent），
+// 適用於測試中以 raw string 撰寫的多行文字。只含空白的行不列入計算，並會被清為空行；
+// 空白需完全相同才視為共同前綴（tab 與空白不會互相抵銷）。換行符號保持原樣。
+//
+//	stringx.Dedent("\n    a\n      b\n") // "\na\n  b\n"
+func Dedent(s string) string {
+	lines := splitLines(s)
+
+	var (
+		margin string
+		found  bool
+	)
+	for _, ln := range lines {
+		if strings.TrimSpace(ln.content) == "" {
+			continue
+		}
+		indent := ln.content[:len(ln.content)-len(strings.TrimLeft(ln.content, " \t"))]
+		if !found {
+			margin, found = indent, true
+			continue
+		}
+		margin = commonPrefix(margin, indent)
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, ln := range lines {
+		if strings.TrimSpace(ln.content) != "" {
+			b.WriteString(ln.content[len(margin):])
+		}
+		b.WriteString(ln.eol)
+	}
+	return b.String()
+}
+
+// TrimLines 移除每一行結尾的空白（換行符號 \n 與 \r\n 保持原樣）。
+func TrimLines(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, ln := range splitLines(s) {
+		b.WriteString(strings.TrimRightFunc(ln.content, unicode.IsSpace))
+		b.WriteString(ln.eol)
+	}
+	return b.String()
+}
+
+// line 為一行文字與其換行符號（""、"\n" 或 "\r\n"）。
+type line struct {
+	content string
+	eol     string
+}
+
+// splitLines 將 s 切分為行並保留各行的換行符號；結尾換行之後不會產生額外的空行。
+func splitLines(s string) []line {
+	lines := make([]line, 0, strings.Count(s, "\n")+1)
+	for s != "" {
+		i := strings.IndexByte(s, '\n')
+		if i < 0 {
+			lines = append(lines, line{content: s})
+			break
+		}
+		content, eol := s[:i], "\n"
+		if strings.HasSuffix(content, "\r") {
+			content, eol = content[:len(content)-1], "\r\n"
+		}
+		lines = append(lines, line{content: content, eol: eol})
+		s = s[i+1:]
+	}
+	return lines
+}
+
+// firstNewline 回傳 s 中第一個換行符號，沒有時回傳 "\n"。
+func firstNewline(s string) string {
+	i := strings.IndexByte(s, '\n')
+	if i > 0 && s[i-1] == '\r' {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// commonPrefix 回傳 a 與 b 的共同前綴。
+func commonPrefix(a, b string) string {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return a[:i]
+		}
+	}
+	return a[:n]
+}
+
+// wrapLine 將單行 content（不含換行）折行寫入 b，折行時寫入 eol。
+func wrapLine(b *strings.Builder, content string, width int, eol string, eastAsian bool) {
+	indent := content[:len(content)-len(strings.TrimLeftFunc(content, unicode.IsSpace))]
+	words := strings.Fields(content)
+	if len(words) == 0 {
+		b.WriteString(content) // 空行或只含空白的行保持原樣
+		return
+	}
+
+	col := textWidth(indent, eastAsian)
+	b.WriteString(indent)
+	for i, w := range words {
+		ww := textWidth(w, eastAsian)
+		if i > 0 {
+			if col+1+ww <= width {
+				b.WriteByte(' ')
+				col++
+			} else {
+				b.WriteString(eol)
+				col = 0
+			}
+		}
+
+		// 單字本身超過一行時才從中切斷
+		for col+ww > width {
+			head, rest := splitAtWidth(w, width-col, eastAsian)
+			if head == "" {
+				// 剩餘欄數放不下任何字元（縮排過寬或全形字元），至少放一個 rune 避免無窮迴圈
+				_, size := utf8.DecodeRuneInString(w)
+				head, rest = w[:size], w[size:]
+			}
+			b.WriteString(head)
+			if rest == "" {
+				col += textWidth(head, eastAsian)
+				w, ww = "", 0
+				break
+			}
+			b.WriteString(eol)
+			w, ww = rest, textWidth(rest, eastAsian)
+			col = 0
+		}
+		b.WriteString(w)
+		col += ww
+	}
+}
+
+// splitAtWidth 將 w 切為不超過 width 欄的前段與剩餘部分。
+func splitAtWidth(w string, width int, eastAsian bool) (string, string) {
+	col := 0
+	for i, r := range w {
+		rw := runeWidth(r, eastAsian)
+		if col+rw > width {
+			return w[:i], w[i:]
+		}
+		col += rw
+	}
+	return w, ""
+}
+
+// textWidth 回傳 s 的顯示欄數。
+func textWidth(s string, eastAsian bool) int {
+	if !eastAsian {
+		return utf8.RuneCountInString(s)
+	}
+	n := 0
+	for _, r := range s {
+		n += runeWidth(r, eastAsian)
+	}
+	return n
+}
+
+// runeWidth 回傳 r 的顯示欄數：eastAsian 時全形字元為 2、組合字元與 ZWJ 等零寬字元為 0，其餘為 1。
+func runeWidth(r rune, eastAsian bool) int {
+	switch {
+	case !eastAsian:
+		return 1
+	case isWideRune(r):
+		return 2
+	case isZeroWidthRune(r):
+		return 0
+	default:
+		return 1
+	}
+}
+
+// isZeroWidthRune 判斷 r 是否不佔顯示寬度：組合字元（Mn、Me）、ZWJ / ZWNJ 與變體選擇符。
+func isZeroWidthRune(r rune) bool {
+	if r < 0x0300 {
+		return false
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me) || r == zeroWidthJoiner || r == '\u200c' ||
+		r >= 0xFE00 && r <= 0xFE0F
+}
+
+// wideRanges 為 East Asian Width 為 W 或 F 的常用區段（由小到大排列）。
+var wideRanges = []struct{ lo, hi rune }{
+	{0x1100, 0x115F},   // Hangul Jamo
+	{0x2E80, 0x303E},   // CJK 部首、符號與標點
+	{0x3041, 0x33FF},   // 平假名、片假名、注音、CJK 相容字
+	{0x3400, 0x4DBF},   // CJK 擴充 A
+	{0x4E00, 0x9FFF},   // CJK 統一漢字
+	{0xA000, 0xA4CF},   // 彝文
+	{0xAC00, 0xD7A3},   // 韓文音節
+	{0xF900, 0xFAFF},   // CJK 相容漢字
+	{0xFE30, 0xFE4F},   // CJK 相容形式
+	{0xFF00, 0xFF60},   // 全形 ASCII
+	{0xFFE0, 0xFFE6},   // 全形符號
+	{0x1F300, 0x1F64F}, // emoji
+	{0x1F900, 0x1F9FF}, // emoji 補充
+	{0x20000, 0x3FFFD}, // CJK 擴充 B 之後
+}
+
+// isWideRune 判斷 r 是否為東亞全形字元。
+func isWideRune(r rune) bool {
+	if r < wideRanges[0].lo {
+		return false
+	}
+	for _, rg := range wideRanges {
+		if r < rg.lo {
+			return false
+		}
+		if r <= rg.hi {
+			return true
+		}
+	}
+	return false
+}
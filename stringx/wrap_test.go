@@ -0,0 +1,139 @@
+package stringx
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  string
+	}{
+		{"empty", "", 10, ""},
+		{"fits", "hello", 10, "hello"},
+		{"word_boundaries", "The quick brown fox jumps", 10, "The quick\nbrown fox\njumps"},
+		{"exact_width", "aaaa bbbb", 4, "aaaa\nbbbb"},
+		{"long_word_broken", "see https://example.com/very/long", 10, "see\nhttps://ex\nample.com/\nvery/long"},
+		{"collapse_spaces", "a   b\t c", 10, "a b c"},
+		{"keep_indent_first_line", "  indented text here", 10, "  indented\ntext here"},
+		{"paragraphs", "one two\n\nthree four", 5, "one\ntwo\n\nthree\nfour"},
+		{"crlf_preserved", "one two\r\nthree four", 5, "one\r\ntwo\r\nthree\r\nfour"},
+		{"mixed_kept_per_line", "aa bb\nc d\r\n", 2, "aa\nbb\nc\r\nd\r\n"},
+		{"trailing_newline", "aa bb\n", 2, "aa\nbb\n"},
+		{"runes_not_bytes", "台北市 信義區", 3, "台北市\n信義區"},
+		{"cjk_long_word", "台北市信義區", 4, "台北市信\n義區"},
+		{"zero_width", "a b", 0, "a b"},
+		{"width_one", "ab c", 1, "a\nb\nc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.in, tt.width); got != tt.want {
+				t.Errorf("Wrap(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapWithOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		opts  WrapOptions
+		want  string
+	}{
+		{"east_asian_width", "台北市 信義區", 6, WrapOptions{EastAsianWidth: true}, "台北市\n信義區"},
+		{"east_asian_break", "台北市信義區", 5, WrapOptions{EastAsianWidth: true}, "台北\n市信\n義區"},
+		{"east_asian_mixed", "ab 台北", 5, WrapOptions{EastAsianWidth: true}, "ab\n台北"},
+		{"wide_rune_wider_than_width", "台北", 1, WrapOptions{EastAsianWidth: true}, "台\n北"},
+		{"normalize_to_lf", "one two\r\nthree", 5, WrapOptions{Newline: "\n"}, "one\ntwo\nthree"},
+		{"normalize_to_crlf", "one two\nthree\n", 5, WrapOptions{Newline: "\r\n"}, "one\r\ntwo\r\nthree\r\n"},
+		{"last_line_uses_first_eol", "x\r\none two", 3, WrapOptions{}, "x\r\none\r\ntwo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WrapWithOptions(tt.in, tt.width, tt.opts); got != tt.want {
+				t.Errorf("WrapWithOptions(%q, %d, %+v) = %q, want %q", tt.in, tt.width, tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndent(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		prefix string
+		want   string
+	}{
+		{"empty", "", "> ", ""},
+		{"single", "a", "> ", "> a"},
+		{"trailing_newline", "a\nb\n", "  ", "  a\n  b\n"},
+		{"blank_line", "a\n\nb", "> ", "> a\n> \n> b"},
+		{"only_newline", "\n", "> ", "> \n"},
+		{"crlf", "a\r\nb\r\n", "\t", "\ta\r\n\tb\r\n"},
+		{"empty_prefix", "a\nb", "", "a\nb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Indent(tt.in, tt.prefix); got != tt.want {
+				t.Errorf("Indent(%q, %q) = %q, want %q", tt.in, tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedent(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"no_indent", "a\n  b", "a\n  b"},
+		{"common_indent", "\n    a\n      b\n", "\na\n  b\n"},
+		{"blank_lines_ignored", "    a\n\n    b", "a\n\nb"},
+		{"whitespace_line_cleared", "    a\n  \n    b", "a\n\nb"},
+		{"tabs", "\ta\n\t\tb", "a\n\tb"},
+		{"mixed_tabs_spaces", "\ta\n    b", "\ta\n    b"},
+		{"crlf", "  a\r\n  b\r\n", "a\r\nb\r\n"},
+		{"raw_string_literal", `
+		SELECT *
+		FROM users
+		  WHERE id = 1
+	`, "\nSELECT *\nFROM users\n  WHERE id = 1\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Dedent(tt.in); got != tt.want {
+				t.Errorf("Dedent(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrimLines(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"trailing_spaces", "a  \nb\t\n", "a\nb\n"},
+		{"keeps_leading", "  a  ", "  a"},
+		{"crlf_preserved", "a \r\nb\t\r\n", "a\r\nb\r\n"},
+		{"blank_lines", "a\n   \nb", "a\n\nb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TrimLines(tt.in); got != tt.want {
+				t.Errorf("TrimLines(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
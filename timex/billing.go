@@ -0,0 +1,73 @@
+package timex
+
+import "time"
+
+// BillingCycle 描述以每月固定日（anchor day）為基準的計費週期。
+// 目標月份沒有 AnchorDay 時取月底，但下一個月仍以 AnchorDay 計算：
+// 1/31 簽約的計費日為 2/28、3/31、4/30……，不會像 AddDate 一樣在 2 月後固定漂移到 28 日。
+// 計費日皆為 Location 時區的當地 00:00。
+type BillingCycle struct {
+	// Start 為簽約時間，第 0 期從 Start 的當地日期開始。
+	Start time.Time
+	// AnchorDay 為每月計費日（1–31）。
+	AnchorDay int
+	// Location 為計算當地日期的時區，nil 時使用 UTC。
+	Location *time.Location
+}
+
+// NewBillingCycle 建立以 start 的當地日期為計費日的 BillingCycle。
+func NewBillingCycle(start time.Time, loc *time.Location) BillingCycle {
+	loc = locationOrUTC(loc)
+	return BillingCycle{Start: start, AnchorDay: start.In(loc).Day(), Location: loc}
+}
+
+// NextBillingDate 回傳嚴格晚於 after 的下一個計費日（UTC），不早於第 0 期的開始。
+func (c BillingCycle) NextBillingDate(after time.Time) time.Time {
+	loc := c.location()
+	sy, sm, _ := c.Start.In(loc).Date()
+	ay, am, _ := after.In(loc).Date()
+
+	// 先以月份差估算，再往後找到第一個晚於 after 的計費日
+	k := max((ay-sy)*12+int(am-sm)-1, 0)
+	for !c.billingDate(k).After(after) {
+		k++
+	}
+	return c.billingDate(k)
+}
+
+// BillingPeriod 回傳第 n 期（從 0 起算）的計費區間 [第 n 個計費日, 第 n+1 個計費日)，皆為 UTC。
+func (c BillingCycle) BillingPeriod(n int) TimeRange {
+	return TimeRange{Start: c.billingDate(n), End: c.billingDate(n + 1)}
+}
+
+// billingDate 回傳 Start 所在月份之後第 k 個月的計費日（當地 00:00，UTC）。
+func (c BillingCycle) billingDate(k int) time.Time {
+	loc := c.location()
+	y, m, _ := c.Start.In(loc).Date()
+	return anchoredDate(y, m+time.Month(k), c.AnchorDay, time.Time{}, loc)
+}
+
+func (c BillingCycle) location() *time.Location {
+	return locationOrUTC(c.Location)
+}
+
+// SameDayNextMonth 回傳 t 在 loc 時區的下一個月中第 anchorDay 日、保留當地牆上時間的時刻（UTC）。
+// 下個月沒有 anchorDay 時取月底；anchorDay 限制在 1–31。loc 為 nil 時視為 UTC。
+// 與 AddMonths 不同，日期以 anchorDay 而非 t 的日期為準，連續呼叫不會漂移：
+//
+//	t := SameDayNextMonth(jan31, 31, loc) // 2/28
+//	t = SameDayNextMonth(t, 31, loc)      // 3/31（而非 3/28）
+func SameDayNextMonth(t time.Time, anchorDay int, loc *time.Location) time.Time {
+	loc = locationOrUTC(loc)
+	local := t.In(loc)
+	y, m, _ := local.Date()
+	return anchoredDate(y, m+1, anchorDay, local, loc)
+}
+
+// anchoredDate 回傳正規化後的 y 年 m 月第 anchorDay 日（超過當月天數時取月底），
+// 時分秒取自 clock，夏令時間邊界的處理見 resolveWallClock。
+func anchoredDate(y int, m time.Month, anchorDay int, clock time.Time, loc *time.Location) time.Time {
+	first := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	day := min(max(anchorDay, 1), daysIn(first.Year(), first.Month()))
+	return resolveWallClock(first.Year(), first.Month(), day, clock, loc).UTC()
+}
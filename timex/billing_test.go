@@ -0,0 +1,157 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSameDayNextMonth(t *testing.T) {
+	taipei := mustLoad(t, "Asia/Taipei")
+
+	tests := []struct {
+		name   string
+		in     time.Time
+		anchor int
+		want   time.Time
+	}{
+		{"normal", time.Date(2025, 1, 15, 9, 30, 0, 0, taipei), 15, time.Date(2025, 2, 15, 9, 30, 0, 0, taipei)},
+		{"clamp_feb", time.Date(2025, 1, 31, 9, 0, 0, 0, taipei), 31, time.Date(2025, 2, 28, 9, 0, 0, 0, taipei)},
+		{"clamp_feb_leap", time.Date(2024, 1, 31, 9, 0, 0, 0, taipei), 31, time.Date(2024, 2, 29, 9, 0, 0, 0, taipei)},
+		// 從 2/28 繼續前進時回到錨點 31 日
+		{"restore_anchor", time.Date(2025, 2, 28, 9, 0, 0, 0, taipei), 31, time.Date(2025, 3, 31, 9, 0, 0, 0, taipei)},
+		{"clamp_30_day_month", time.Date(2025, 3, 31, 9, 0, 0, 0, taipei), 31, time.Date(2025, 4, 30, 9, 0, 0, 0, taipei)},
+		{"year_boundary", time.Date(2025, 12, 31, 9, 0, 0, 0, taipei), 31, time.Date(2026, 1, 31, 9, 0, 0, 0, taipei)},
+		{"anchor_differs_from_day", time.Date(2025, 2, 28, 0, 0, 0, 0, taipei), 30, time.Date(2025, 3, 30, 0, 0, 0, 0, taipei)},
+		{"anchor_clamped_low", time.Date(2025, 1, 10, 0, 0, 0, 0, taipei), 0, time.Date(2025, 2, 1, 0, 0, 0, 0, taipei)},
+		{"anchor_clamped_high", time.Date(2025, 1, 10, 0, 0, 0, 0, taipei), 40, time.Date(2025, 2, 28, 0, 0, 0, 0, taipei)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SameDayNextMonth(tt.in, tt.anchor, taipei)
+			if !got.Equal(tt.want) {
+				t.Errorf("SameDayNextMonth() = %v, want %v", got.In(taipei), tt.want)
+			}
+		})
+	}
+}
+
+func TestSameDayNextMonth_NoDrift(t *testing.T) {
+	// 連續 13 個月：錨點 31 日在 2 月之後不會漂移到 28 日
+	d := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	wantDays := []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31, 31}
+	for i, want := range wantDays {
+		d = SameDayNextMonth(d, 31, time.UTC)
+		if d.Day() != want {
+			t.Fatalf("step %d: got %v, want day %d", i+1, d, want)
+		}
+	}
+	if d.Year() != 2025 || d.Month() != time.January {
+		t.Errorf("final date = %v, want 2025-01-31", d)
+	}
+}
+
+func TestSameDayNextMonth_NilLocation(t *testing.T) {
+	in := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+	want := time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC)
+	if got := SameDayNextMonth(in, 31, nil); !got.Equal(want) {
+		t.Errorf("SameDayNextMonth(nil) = %v, want %v", got, want)
+	}
+}
+
+func TestBillingCycle_NextBillingDate(t *testing.T) {
+	taipei := mustLoad(t, "Asia/Taipei")
+	signup := time.Date(2025, 1, 31, 15, 0, 0, 0, taipei)
+	c := NewBillingCycle(signup, taipei)
+
+	if c.AnchorDay != 31 {
+		t.Fatalf("AnchorDay = %d, want 31", c.AnchorDay)
+	}
+
+	tests := []struct {
+		name  string
+		after time.Time
+		want  time.Time
+	}{
+		{"from_signup", signup, time.Date(2025, 2, 28, 0, 0, 0, 0, taipei)},
+		{"before_signup", signup.AddDate(0, -2, 0), time.Date(2025, 1, 31, 0, 0, 0, 0, taipei)},
+		{"exactly_on_billing_date", time.Date(2025, 2, 28, 0, 0, 0, 0, taipei), time.Date(2025, 3, 31, 0, 0, 0, 0, taipei)},
+		{"mid_march", time.Date(2025, 3, 15, 0, 0, 0, 0, taipei), time.Date(2025, 3, 31, 0, 0, 0, 0, taipei)},
+		{"end_of_april", time.Date(2025, 4, 30, 12, 0, 0, 0, taipei), time.Date(2025, 5, 31, 0, 0, 0, 0, taipei)},
+		{"leap_year", time.Date(2028, 2, 1, 0, 0, 0, 0, taipei), time.Date(2028, 2, 29, 0, 0, 0, 0, taipei)},
+		{"year_boundary", time.Date(2025, 12, 31, 0, 0, 0, 1, taipei), time.Date(2026, 1, 31, 0, 0, 0, 0, taipei)},
+		// after 以 UTC 表示，仍以台北當地日期判斷
+		{"after_in_utc", time.Date(2025, 3, 30, 16, 0, 0, 0, time.UTC), time.Date(2025, 4, 30, 0, 0, 0, 0, taipei)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := c.NextBillingDate(tt.after)
+			if !got.Equal(tt.want) {
+				t.Errorf("NextBillingDate(%v) = %v, want %v", tt.after, got.In(taipei), tt.want)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("NextBillingDate() location = %v, want UTC", got.Location())
+			}
+		})
+	}
+}
+
+func TestBillingCycle_BillingPeriod(t *testing.T) {
+	c := BillingCycle{Start: time.Date(2024, 11, 30, 8, 0, 0, 0, time.UTC), AnchorDay: 30}
+
+	tests := []struct {
+		n          int
+		start, end time.Time
+	}{
+		{0, time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{1, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC)},
+		{2, time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{3, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC)},
+		{15, time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		p := c.BillingPeriod(tt.n)
+		if !p.Start.Equal(tt.start) || !p.End.Equal(tt.end) {
+			t.Errorf("BillingPeriod(%d) = [%v, %v), want [%v, %v)", tt.n, p.Start, p.End, tt.start, tt.end)
+		}
+	}
+
+	// 相鄰期間首尾相接，且 NextBillingDate 落在下一期開始
+	for n := 0; n < 24; n++ {
+		p, next := c.BillingPeriod(n), c.BillingPeriod(n+1)
+		if !p.End.Equal(next.Start) {
+			t.Fatalf("period %d end %v != period %d start %v", n, p.End, n+1, next.Start)
+		}
+		if !p.Contains(p.Start) || p.Contains(p.End) {
+			t.Fatalf("period %d should be half-open", n)
+		}
+		if got := c.NextBillingDate(p.Start); !got.Equal(p.End) {
+			t.Fatalf("NextBillingDate(period %d start) = %v, want %v", n, got, p.End)
+		}
+	}
+}
+
+func TestBillingCycle_NilLocation(t *testing.T) {
+	c := BillingCycle{Start: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), AnchorDay: 31}
+	want := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+	if got := c.NextBillingDate(c.Start); !got.Equal(want) {
+		t.Errorf("NextBillingDate() = %v, want %v", got, want)
+	}
+}
+
+func TestTimeRange(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := TimeRange{Start: start, End: start.Add(time.Hour)}
+
+	if r.Duration() != time.Hour {
+		t.Errorf("Duration() = %v, want 1h", r.Duration())
+	}
+	if !r.Contains(start) || !r.Contains(start.Add(59*time.Minute)) {
+		t.Error("Contains() should include Start and inner points")
+	}
+	if r.Contains(r.End) || r.Contains(start.Add(-time.Nanosecond)) {
+		t.Error("Contains() should exclude End and points before Start")
+	}
+}
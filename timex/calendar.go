@@ -0,0 +1,65 @@
+package timex
+
+import "time"
+
+// AddDays 以 loc 時區的日曆日加減天數，並保留當地牆上時間（wall clock），回傳 UTC。
+// 與 t.Add(24*time.Hour) 不同，跨越夏令時間切換時仍落在相同的當地時刻。
+// 結果的當地時間不存在或重複時的處理方式見 resolveWallClock。loc 為 nil 時視為 UTC。
+func AddDays(t time.Time, days int, loc *time.Location) time.Time {
+	loc = locationOrUTC(loc)
+	local := t.In(loc)
+	y, m, d := local.Date()
+
+	// 先以 UTC 正規化日期（處理跨月、跨年）
+	date := time.Date(y, m, d+days, 0, 0, 0, 0, time.UTC)
+	return resolveWallClock(date.Year(), date.Month(), date.Day(), local, loc).UTC()
+}
+
+// AddMonths 以 loc 時區的日曆月加減月數，並保留當地牆上時間，回傳 UTC。
+// 目標月份沒有對應日期時取該月最後一天（1/31 + 1 個月 = 2/28 或 2/29），而非溢位到 3 月。
+// 結果的當地時間不存在或重複時的處理方式見 resolveWallClock。loc 為 nil 時視為 UTC。
+func AddMonths(t time.Time, months int, loc *time.Location) time.Time {
+	loc = locationOrUTC(loc)
+	local := t.In(loc)
+	y, m, d := local.Date()
+
+	// 以每月 1 日正規化目標年月，再將日期限制在當月天數內
+	first := time.Date(y, m+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	day := min(d, daysIn(first.Year(), first.Month()))
+	return resolveWallClock(first.Year(), first.Month(), day, local, loc).UTC()
+}
+
+// resolveWallClock 建立 loc 時區下指定日期、且時分秒與 clock 相同的時間，明確處理夏令時間邊界：
+//   - 當地時間不存在（春季跳時，例如 02:30 被跳過）：往後推移跳過的長度（02:30 → 03:30）
+//   - 當地時間重複（秋季回撥，例如 01:30 出現兩次）：取較早的時刻（切換前的偏移量）
+//
+// time.Date 在上述情況不保證回傳哪一個時刻，因此自行計算。
+func resolveWallClock(y int, m time.Month, d int, clock time.Time, loc *time.Location) time.Time {
+	hh, mm, ss := clock.Clock()
+	ns := clock.Nanosecond()
+
+	// 將牆上時間視為 UTC，再以候選偏移量換算實際時刻
+	naive := time.Date(y, m, d, hh, mm, ss, ns, time.UTC)
+	_, offBefore := naive.Add(-36 * time.Hour).In(loc).Zone()
+	_, offAfter := naive.Add(36 * time.Hour).In(loc).Zone()
+
+	var (
+		best  time.Time
+		found bool
+	)
+	for _, off := range []int{offBefore, offAfter} {
+		cand := naive.Add(-time.Duration(off) * time.Second)
+		if _, got := cand.In(loc).Zone(); got != off {
+			continue // 此偏移量下該牆上時間不成立
+		}
+		if !found || cand.Before(best) {
+			best, found = cand, true
+		}
+	}
+	if found {
+		return best.In(loc)
+	}
+
+	// 牆上時間落在跳時區間：以切換前的偏移量換算，結果即為往後推移
+	return naive.Add(-time.Duration(offBefore) * time.Second).In(loc)
+}
@@ -0,0 +1,113 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("%s not available: %v", name, err)
+	}
+	return loc
+}
+
+func TestAddDays_NewYork(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name string
+		in   time.Time
+		days int
+		want time.Time
+	}{
+		// 2025-03-09 02:00 EST → 03:00 EDT：當地 09:00 保持不變，實際只經過 23 小時
+		{"spring_keeps_wall_clock", time.Date(2025, 3, 8, 9, 0, 0, 0, ny), 1, time.Date(2025, 3, 9, 13, 0, 0, 0, time.UTC)},
+		// 02:30 在 2025-03-09 不存在，往後推移至 03:30 EDT
+		{"spring_gap_skips_forward", time.Date(2025, 3, 8, 2, 30, 0, 0, ny), 1, time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC)},
+		// 2025-11-02 01:30 出現兩次，取較早的 EDT
+		{"fall_ambiguous_earlier", time.Date(2025, 11, 1, 1, 30, 0, 0, ny), 1, time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC)},
+		// 秋季回撥：當地 09:00 保持不變，實際經過 25 小時
+		{"fall_keeps_wall_clock", time.Date(2025, 11, 1, 9, 0, 0, 0, ny), 1, time.Date(2025, 11, 2, 14, 0, 0, 0, time.UTC)},
+		{"negative", time.Date(2025, 3, 10, 9, 0, 0, 0, ny), -2, time.Date(2025, 3, 8, 14, 0, 0, 0, time.UTC)},
+		{"month_overflow", time.Date(2025, 1, 31, 9, 0, 0, 0, ny), 1, time.Date(2025, 2, 1, 14, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AddDays(tt.in, tt.days, ny)
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("AddDays() = %v (%v), want %v", got, got.In(ny), tt.want)
+			}
+		})
+	}
+}
+
+func TestAddDays_LordHowe(t *testing.T) {
+	// Lord Howe 夏令時間僅差 30 分鐘：+10:30 ↔ +11:00
+	lh := mustLoad(t, "Australia/Lord_Howe")
+
+	tests := []struct {
+		name string
+		in   time.Time
+		want time.Time
+	}{
+		// 2025-10-05 02:00 → 02:30，02:15 不存在，往後推移 30 分鐘至 02:45（+11）
+		{"spring_gap", time.Date(2025, 10, 4, 2, 15, 0, 0, lh), time.Date(2025, 10, 4, 15, 45, 0, 0, time.UTC)},
+		// 2025-04-06 02:00 → 01:30，01:45 出現兩次，取較早的 +11
+		{"fall_ambiguous", time.Date(2025, 4, 5, 1, 45, 0, 0, lh), time.Date(2025, 4, 5, 14, 45, 0, 0, time.UTC)},
+		// 一般時刻保持牆上時間（跨越切換日）
+		{"keeps_wall_clock", time.Date(2025, 10, 4, 12, 0, 0, 0, lh), time.Date(2025, 10, 5, 1, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AddDays(tt.in, 1, lh)
+			if !got.Equal(tt.want) {
+				t.Errorf("AddDays() = %v (%v), want %v", got, got.In(lh), tt.want)
+			}
+		})
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name   string
+		in     time.Time
+		months int
+		want   time.Time
+	}{
+		{"clamp_non_leap", time.Date(2025, 1, 31, 10, 0, 0, 0, ny), 1, time.Date(2025, 2, 28, 10, 0, 0, 0, ny)},
+		{"clamp_leap", time.Date(2024, 1, 31, 10, 0, 0, 0, ny), 1, time.Date(2024, 2, 29, 10, 0, 0, 0, ny)},
+		{"clamp_30_days", time.Date(2025, 3, 31, 10, 0, 0, 0, ny), 1, time.Date(2025, 4, 30, 10, 0, 0, 0, ny)},
+		{"negative_clamp", time.Date(2025, 3, 31, 10, 0, 0, 0, ny), -1, time.Date(2025, 2, 28, 10, 0, 0, 0, ny)},
+		{"year_wrap", time.Date(2025, 11, 15, 10, 0, 0, 0, ny), 3, time.Date(2026, 2, 15, 10, 0, 0, 0, ny)},
+		// 跨越 DST 仍保持當地 10:00（EST → EDT）
+		{"across_dst", time.Date(2025, 2, 15, 10, 0, 0, 0, ny), 1, time.Date(2025, 3, 15, 14, 0, 0, 0, time.UTC)},
+		// 目標日的 02:30 不存在，往後推移至 03:30 EDT
+		{"dst_gap", time.Date(2025, 2, 9, 2, 30, 0, 0, ny), 1, time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AddMonths(tt.in, tt.months, ny)
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("AddMonths() = %v (%v), want %v", got, got.In(ny), tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendar_NilLocation(t *testing.T) {
+	in := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+	if got, want := AddDays(in, 1, nil), time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("AddDays(nil) = %v, want %v", got, want)
+	}
+	if got, want := AddMonths(in, 1, nil), time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("AddMonths(nil) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,69 @@
+package timex
+
+import "time"
+
+// MaxTime 回傳 ts 中最晚的時間；ts 為空時回傳零值與 ok=false。
+// 零值 time.Time 與其他時間一樣參與比較（永遠是最早的）；需略過零值請使用 MaxNonZeroTime。
+func MaxTime(ts ...time.Time) (max time.Time, ok bool) {
+	return pickTime(ts, false, time.Time.After)
+}
+
+// MinTime 回傳 ts 中最早的時間；ts 為空時回傳零值與 ok=false。
+// 零值 time.Time 參與比較，只要 ts 中有零值就會被回傳；需略過零值請使用 MinNonZeroTime。
+func MinTime(ts ...time.Time) (min time.Time, ok bool) {
+	return pickTime(ts, false, time.Time.Before)
+}
+
+// MaxNonZeroTime 同 MaxTime，但略過零值（例如取多個分片中最新且已設定的 updated_at）。
+// ts 為空或全為零值時回傳零值與 ok=false。
+func MaxNonZeroTime(ts ...time.Time) (max time.Time, ok bool) {
+	return pickTime(ts, true, time.Time.After)
+}
+
+// MinNonZeroTime 同 MinTime，但略過零值。ts 為空或全為零值時回傳零值與 ok=false。
+func MinNonZeroTime(ts ...time.Time) (min time.Time, ok bool) {
+	return pickTime(ts, true, time.Time.Before)
+}
+
+// pickTime 回傳 ts 中使 better(t, best) 成立的時間。
+func pickTime(ts []time.Time, skipZero bool, better func(t, u time.Time) bool) (time.Time, bool) {
+	var (
+		best  time.Time
+		found bool
+	)
+	for _, t := range ts {
+		if skipZero && t.IsZero() {
+			continue
+		}
+		if !found || better(t, best) {
+			best, found = t, true
+		}
+	}
+	return best, found
+}
+
+// ClampTime 將 t 限制在 [lo, hi] 區間內：早於 lo 時回傳 lo，晚於 hi 時回傳 hi，否則回傳 t。
+// lo 晚於 hi 時會先交換兩者。
+func ClampTime(t, lo, hi time.Time) time.Time {
+	if hi.Before(lo) {
+		lo, hi = hi, lo
+	}
+	switch {
+	case t.Before(lo):
+		return lo
+	case t.After(hi):
+		return hi
+	default:
+		return t
+	}
+}
+
+// IsBetween 判斷 t 是否位於 start 與 end 之間。
+// inclusive 為 true 時包含兩端點（start ≤ t ≤ end），否則不包含（start < t < end）。
+// start 晚於 end 時一律回傳 false。
+func IsBetween(t, start, end time.Time, inclusive bool) bool {
+	if inclusive {
+		return !t.Before(start) && !t.After(end)
+	}
+	return t.After(start) && t.Before(end)
+}
@@ -0,0 +1,118 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaxMinTime(t *testing.T) {
+	t1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	t3 := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	var zero time.Time
+
+	tests := []struct {
+		name   string
+		fn     func(...time.Time) (time.Time, bool)
+		in     []time.Time
+		want   time.Time
+		wantOK bool
+	}{
+		{"max", MaxTime, []time.Time{t2, t3, t1}, t3, true},
+		{"max_single", MaxTime, []time.Time{t1}, t1, true},
+		{"max_empty", MaxTime, nil, zero, false},
+		{"max_with_zero", MaxTime, []time.Time{zero, t1}, t1, true},
+		{"min", MinTime, []time.Time{t2, t1, t3}, t1, true},
+		{"min_empty", MinTime, nil, zero, false},
+		{"min_zero_participates", MinTime, []time.Time{t2, zero, t1}, zero, true},
+		{"max_non_zero", MaxNonZeroTime, []time.Time{zero, t2, zero, t1}, t2, true},
+		{"max_non_zero_all_zero", MaxNonZeroTime, []time.Time{zero, zero}, zero, false},
+		{"min_non_zero", MinNonZeroTime, []time.Time{t2, zero, t3}, t2, true},
+		{"min_non_zero_empty", MinNonZeroTime, nil, zero, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.fn(tt.in...)
+			if !got.Equal(tt.want) || ok != tt.wantOK {
+				t.Errorf("got (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMaxTime_DifferentLocations(t *testing.T) {
+	taipei := time.FixedZone("CST", 8*3600)
+	// 同一時刻的不同表示，比較的是實際時刻而非牆上時間
+	a := time.Date(2025, 1, 1, 10, 0, 0, 0, taipei) // 02:00 UTC
+	b := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	got, _ := MaxTime(a, b)
+	if !got.Equal(b) {
+		t.Errorf("MaxTime() = %v, want %v", got, b)
+	}
+}
+
+func TestClampTime(t *testing.T) {
+	lo := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	hi := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	mid := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		t      time.Time
+		lo, hi time.Time
+		want   time.Time
+	}{
+		{"inside", mid, lo, hi, mid},
+		{"before", lo.AddDate(0, 0, -1), lo, hi, lo},
+		{"after", hi.AddDate(0, 0, 1), lo, hi, hi},
+		{"on_lo", lo, lo, hi, lo},
+		{"on_hi", hi, lo, hi, hi},
+		{"zero_time", time.Time{}, lo, hi, lo},
+		{"swapped_bounds", hi.AddDate(1, 0, 0), hi, lo, hi},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClampTime(tt.t, tt.lo, tt.hi); !got.Equal(tt.want) {
+				t.Errorf("ClampTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsBetween(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	mid := start.Add(12 * time.Hour)
+
+	tests := []struct {
+		name      string
+		t         time.Time
+		inclusive bool
+		want      bool
+	}{
+		{"mid_inclusive", mid, true, true},
+		{"mid_exclusive", mid, false, true},
+		{"start_inclusive", start, true, true},
+		{"start_exclusive", start, false, false},
+		{"end_inclusive", end, true, true},
+		{"end_exclusive", end, false, false},
+		{"before", start.Add(-time.Nanosecond), true, false},
+		{"after", end.Add(time.Nanosecond), true, false},
+		{"zero_time", time.Time{}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBetween(tt.t, start, end, tt.inclusive); got != tt.want {
+				t.Errorf("IsBetween(%v, inclusive=%v) = %v, want %v", tt.t, tt.inclusive, got, tt.want)
+			}
+		})
+	}
+
+	if IsBetween(mid, end, start, true) {
+		t.Error("IsBetween() with start after end should be false")
+	}
+}
@@ -0,0 +1,87 @@
+package timex
+
+import "time"
+
+// civilDate 取出 t 在 loc 時區下的年月日；loc 為 nil 時視為 UTC。
+func civilDate(t time.Time, loc *time.Location) (int, time.Month, int) {
+	return t.In(locationOrUTC(loc)).Date()
+}
+
+// daysIn 回傳指定年月的天數。
+func daysIn(y int, m time.Month) int {
+	// 下個月的第 0 天即為本月最後一天
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Age 計算 birthDate 在 asOf 當下（以 loc 時區的日曆日為準）的足歲年齡。
+// 2/29 出生者在非閏年視為 3/1 才滿歲（即 2/28 當天尚未滿歲）。
+// 若 asOf 早於 birthDate，回傳 0。loc 為 nil 時視為 UTC。
+func Age(birthDate, asOf time.Time, loc *time.Location) int {
+	by, bm, bd := civilDate(birthDate, loc)
+	ay, am, ad := civilDate(asOf, loc)
+
+	// 2/29 生日在非閏年順延為 3/1
+	if bm == time.February && bd == 29 && daysIn(ay, time.February) == 28 {
+		bm, bd = time.March, 1
+	}
+
+	age := ay - by
+	if am < bm || (am == bm && ad < bd) {
+		age-- // 今年生日尚未到
+	}
+	if age < 0 {
+		return 0
+	}
+	return age
+}
+
+// DateDiff 計算 a 到 b 在 loc 時區下的日曆差距（年、月、日），而非以 Duration 換算。
+// 例如 1/31 → 2/28 為 0 年 0 月 28 日；1/31 → 3/1 為 0 年 1 月 1 日
+// （月份錨點遇到不存在的日期時，取該月最後一天）。
+// 若 a 晚於 b，各值皆為負數。loc 為 nil 時視為 UTC。
+func DateDiff(a, b time.Time, loc *time.Location) (years, months, days int) {
+	if DaysBetween(a, b, loc) < 0 {
+		y, m, d := DateDiff(b, a, loc)
+		return -y, -m, -d
+	}
+
+	ay, am, ad := civilDate(a, loc)
+	by, bm, bd := civilDate(b, loc)
+
+	// 完整月數：日期未到錨點日則少算一個月
+	total := (by-ay)*12 + int(bm-am)
+	if bd < ad {
+		total--
+	}
+
+	// 以 a 往後推 total 個月作為錨點，日期超出當月天數時取月底
+	anchorY, anchorM := ay, am+time.Month(total)
+	anchor := time.Date(anchorY, anchorM, 1, 0, 0, 0, 0, time.UTC)
+	anchorD := min(ad, daysIn(anchor.Year(), anchor.Month()))
+	anchor = anchor.AddDate(0, 0, anchorD-1)
+
+	end := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	days = int(end.Sub(anchor).Hours() / 24)
+
+	return total / 12, total % 12, days
+}
+
+// MonthsBetween 計算 a 到 b 在 loc 時區下經過的完整月數。
+// 若 a 晚於 b，回傳負數。loc 為 nil 時視為 UTC。
+func MonthsBetween(a, b time.Time, loc *time.Location) int {
+	y, m, _ := DateDiff(a, b, loc)
+	return y*12 + m
+}
+
+// DaysBetween 計算 a 到 b 在 loc 時區下跨越的日曆日數（而非 24 小時區塊數）。
+// 在夏令時間切換日，一天可能只有 23 或 25 小時，仍計為 1 天。
+// 若 a 晚於 b，回傳負數。loc 為 nil 時視為 UTC。
+func DaysBetween(a, b time.Time, loc *time.Location) int {
+	ay, am, ad := civilDate(a, loc)
+	by, bm, bd := civilDate(b, loc)
+
+	// 以 UTC 零點比較，避免 DST 造成的小時差
+	start := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	end := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(start).Hours() / 24)
+}
@@ -0,0 +1,158 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAge(t *testing.T) {
+	loc := time.UTC
+	tests := []struct {
+		name  string
+		birth time.Time
+		asOf  time.Time
+		want  int
+	}{
+		{"before_birthday", time.Date(1990, 5, 20, 0, 0, 0, 0, loc), time.Date(2025, 5, 19, 0, 0, 0, 0, loc), 34},
+		{"on_birthday", time.Date(1990, 5, 20, 0, 0, 0, 0, loc), time.Date(2025, 5, 20, 0, 0, 0, 0, loc), 35},
+		{"after_birthday", time.Date(1990, 5, 20, 0, 0, 0, 0, loc), time.Date(2025, 12, 1, 0, 0, 0, 0, loc), 35},
+		{"leap_day_feb28_non_leap", time.Date(2000, 2, 29, 0, 0, 0, 0, loc), time.Date(2025, 2, 28, 0, 0, 0, 0, loc), 24},
+		{"leap_day_mar1_non_leap", time.Date(2000, 2, 29, 0, 0, 0, 0, loc), time.Date(2025, 3, 1, 0, 0, 0, 0, loc), 25},
+		{"leap_day_leap_year", time.Date(2000, 2, 29, 0, 0, 0, 0, loc), time.Date(2024, 2, 29, 0, 0, 0, 0, loc), 24},
+		{"future_birth", time.Date(2030, 1, 1, 0, 0, 0, 0, loc), time.Date(2025, 1, 1, 0, 0, 0, 0, loc), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Age(tt.birth, tt.asOf, loc); got != tt.want {
+				t.Errorf("Age() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAge_Location(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Taipei")
+	birth := time.Date(1990, 5, 20, 0, 0, 0, 0, time.UTC)
+	// 2025-05-19 16:00 UTC = 2025-05-20 00:00 台北，已滿 35 歲
+	asOf := time.Date(2025, 5, 19, 16, 0, 0, 0, time.UTC)
+
+	if got := Age(birth, asOf, loc); got != 35 {
+		t.Errorf("Age() = %d, want 35", got)
+	}
+	if got := Age(birth, asOf, time.UTC); got != 34 {
+		t.Errorf("Age(UTC) = %d, want 34", got)
+	}
+}
+
+func TestDateDiff(t *testing.T) {
+	loc := time.UTC
+	tests := []struct {
+		name                string
+		a, b                time.Time
+		wantY, wantM, wantD int
+	}{
+		{"same_day", time.Date(2025, 1, 1, 0, 0, 0, 0, loc), time.Date(2025, 1, 1, 23, 0, 0, 0, loc), 0, 0, 0},
+		{"jan31_feb28", time.Date(2025, 1, 31, 0, 0, 0, 0, loc), time.Date(2025, 2, 28, 0, 0, 0, 0, loc), 0, 0, 28},
+		{"jan31_mar1", time.Date(2025, 1, 31, 0, 0, 0, 0, loc), time.Date(2025, 3, 1, 0, 0, 0, 0, loc), 0, 1, 1},
+		{"one_month", time.Date(2025, 1, 15, 0, 0, 0, 0, loc), time.Date(2025, 2, 15, 0, 0, 0, 0, loc), 0, 1, 0},
+		{"mixed", time.Date(2020, 3, 10, 0, 0, 0, 0, loc), time.Date(2025, 7, 25, 0, 0, 0, 0, loc), 5, 4, 15},
+		{"year_borrow", time.Date(2024, 11, 20, 0, 0, 0, 0, loc), time.Date(2025, 1, 5, 0, 0, 0, 0, loc), 0, 1, 16},
+		{"reversed", time.Date(2025, 2, 15, 0, 0, 0, 0, loc), time.Date(2025, 1, 15, 0, 0, 0, 0, loc), 0, -1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y, m, d := DateDiff(tt.a, tt.b, loc)
+			if y != tt.wantY || m != tt.wantM || d != tt.wantD {
+				t.Errorf("DateDiff() = (%d, %d, %d), want (%d, %d, %d)", y, m, d, tt.wantY, tt.wantM, tt.wantD)
+			}
+		})
+	}
+}
+
+func TestMonthsBetween(t *testing.T) {
+	loc := time.UTC
+	tests := []struct {
+		name string
+		a, b time.Time
+		want int
+	}{
+		{"partial", time.Date(2025, 1, 31, 0, 0, 0, 0, loc), time.Date(2025, 2, 28, 0, 0, 0, 0, loc), 0},
+		{"exact", time.Date(2025, 1, 15, 0, 0, 0, 0, loc), time.Date(2025, 4, 15, 0, 0, 0, 0, loc), 3},
+		{"over_year", time.Date(2023, 6, 1, 0, 0, 0, 0, loc), time.Date(2025, 7, 2, 0, 0, 0, 0, loc), 25},
+		{"reversed", time.Date(2025, 4, 15, 0, 0, 0, 0, loc), time.Date(2025, 1, 15, 0, 0, 0, 0, loc), -3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MonthsBetween(tt.a, tt.b, loc); got != tt.want {
+				t.Errorf("MonthsBetween() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysBetween_DST(t *testing.T) {
+	// Europe/London 於 2025-03-30 01:00 GMT 切換至 BST（當天只有 23 小時）
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("Europe/London not available: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		a, b time.Time
+		want int
+	}{
+		// 相差 23 小時，但跨越 1 個日曆日
+		{"spring_forward_midnight", time.Date(2025, 3, 30, 0, 0, 0, 0, loc), time.Date(2025, 3, 31, 0, 0, 0, 0, loc), 1},
+		// 相差 22.5 小時，跨越 1 個日曆日
+		{"late_to_early", time.Date(2025, 3, 29, 23, 30, 0, 0, loc), time.Date(2025, 3, 30, 23, 0, 0, 0, loc), 1},
+		// 相差不到 24 小時（23:00 → 隔日 00:30），仍跨越 1 日
+		{"short_span", time.Date(2025, 3, 29, 23, 0, 0, 0, loc), time.Date(2025, 3, 30, 0, 30, 0, 0, loc), 1},
+		// 跨越整週 DST 切換
+		{"week", time.Date(2025, 3, 27, 12, 0, 0, 0, loc), time.Date(2025, 4, 3, 12, 0, 0, 0, loc), 7},
+		{"same_day", time.Date(2025, 3, 30, 0, 0, 0, 0, loc), time.Date(2025, 3, 30, 23, 59, 0, 0, loc), 0},
+		{"reversed", time.Date(2025, 3, 31, 0, 0, 0, 0, loc), time.Date(2025, 3, 30, 0, 0, 0, 0, loc), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DaysBetween(tt.a, tt.b, loc); got != tt.want {
+				t.Errorf("DaysBetween() = %d, want %d (duration %v)", got, tt.want, tt.b.Sub(tt.a))
+			}
+		})
+	}
+}
+
+func TestDaysBetween_Location(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Taipei")
+	// 2025-08-18 15:00 UTC = 23:00 台北；2025-08-18 17:00 UTC = 隔日 01:00 台北
+	a := time.Date(2025, 8, 18, 15, 0, 0, 0, time.UTC)
+	b := time.Date(2025, 8, 18, 17, 0, 0, 0, time.UTC)
+
+	if got := DaysBetween(a, b, loc); got != 1 {
+		t.Errorf("DaysBetween(Taipei) = %d, want 1", got)
+	}
+	if got := DaysBetween(a, b, time.UTC); got != 0 {
+		t.Errorf("DaysBetween(UTC) = %d, want 0", got)
+	}
+}
+
+func TestDiff_NilLocation(t *testing.T) {
+	a := time.Date(2000, 2, 29, 23, 0, 0, 0, time.UTC)
+	b := time.Date(2025, 3, 1, 1, 0, 0, 0, time.UTC)
+	if got := Age(a, b, nil); got != 25 {
+		t.Errorf("Age(nil) = %d, want 25", got)
+	}
+	if y, m, d := DateDiff(a, b, nil); y != 25 || m != 0 || d != 1 {
+		t.Errorf("DateDiff(nil) = %d, %d, %d, want 25, 0, 1", y, m, d)
+	}
+	if got := MonthsBetween(a, b, nil); got != 300 {
+		t.Errorf("MonthsBetween(nil) = %d, want 300", got)
+	}
+	if got := DaysBetween(a, a.Add(2*time.Hour), nil); got != 1 {
+		t.Errorf("DaysBetween(nil) = %d, want 1", got)
+	}
+}
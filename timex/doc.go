@@ -10,12 +10,29 @@
 //
 //	start := timex.StartOfDay(time.Now(), time.Local)
 //
+// 日界函式預設回傳 UTC（便於儲存/比較）；*In 版本（StartOfDayIn、EndOfDayIn、
+// FloorToLocalDayIn、CeilToLocalDayIn）保留在 loc 時區，便於直接格式化顯示。
+// loc 為 nil 時視為 UTC：
+//
+//	label := timex.StartOfDayIn(t, loc).Format(time.RFC3339) // 2025-08-19T00:00:00+08:00
+//
 // # 時間截斷
 //
 // 截斷時間至指定粒度：
 //
 //	truncated := timex.TruncateTo(time.Now(), time.Hour)
 //
+// 四捨五入與進位（UTC）；已在邊界上的時間 CeilTo 不會進位：
+//
+//	rounded := timex.RoundTo(t, 15*time.Minute)
+//	ceiled := timex.CeilTo(t, time.Hour)
+//
+// 取當地日界（可正確處理夏令時間）：
+//
+//	end := timex.EndOfDay(t, loc)          // 當天 23:59:59.999999999
+//	floor := timex.FloorToLocalDay(t, loc) // 當地零點
+//	ceil := timex.CeilToLocalDay(t, loc)   // 下一個當地零點
+//
 // # 格式化與解析
 //
 // 格式化時間：
@@ -36,4 +53,128 @@
 //	timex.UnixTimeStamp()   // Unix 秒數
 //	timex.UnixMilliStamp()  // Unix 毫秒數
 //	timex.TimeOnlyStamp()   // 僅時間 "10:30:00"
+//
+// 時間戳使用的格式皆以常數公開（LayoutISO8601Milli、LayoutISO8601CompactTZ、
+// LayoutUTCMilli、LayoutDate、LayoutTimeOnly），可用 ParseStamp 還原：
+//
+//	t, err := timex.ParseStamp(timex.TimeStampUTC())
+//	s := timex.StampIn(loc, timex.LayoutISO8601Milli)
+//
+// # 日曆加減
+//
+// 以當地日曆加減天數 / 月數並保留牆上時間（跨夏令時間仍在相同當地時刻），回傳 UTC：
+//
+//	next := timex.AddDays(t, 1, loc)     // 明天同一當地時刻
+//	due := timex.AddMonths(t, 1, loc)    // 1/31 → 2/28（取月底，不溢位）
+//
+// 當地時間不存在時往後推移（02:30 → 03:30），重複時取較早的時刻。
+//
+// # 比較與限制範圍
+//
+// 取最晚 / 最早時間（空輸入回傳 ok=false，不會 panic）、限制範圍與區間判斷：
+//
+//	latest, ok := timex.MaxNonZeroTime(shardA, shardB, shardC) // 略過未設定的零值
+//	earliest, ok := timex.MinTime(ts...)                       // 零值照常參與比較
+//	t = timex.ClampTime(t, lo, hi)
+//	timex.IsBetween(t, start, end, true) // start ≤ t ≤ end
+//
+// # 計費週期
+//
+// 以簽約日為錨點的每月計費日（當月沒有錨點日時取月底，且不會漂移）：
+//
+//	c := timex.NewBillingCycle(signupAt, loc)  // 1/31 簽約
+//	next := c.NextBillingDate(now)             // 2/28、3/31、4/30 ...
+//	period := c.BillingPeriod(0)               // [1/31, 2/28)
+//	d := timex.SameDayNextMonth(t, 31, loc)
+//
+// # 排程時刻
+//
+// 取得下一個符合當地牆上時間的時刻（嚴格晚於 now，回傳 UTC）：
+//
+//	next := timex.NextOccurrence(now, 2, 30, 0, loc)                           // 每日 02:30
+//	weekly := timex.NextWeekdayOccurrence(now, time.Monday, 9, 0, 0, loc) // 每週一 09:00
+//
+// 當地時間不存在時取跳時後第一個有效時刻（02:30 → 03:00），重複時只取第一次出現。
+//
+// # JSON 時間型別
+//
+// 可直接用於 API 與 DB model 的時間型別（實作 json.Marshaler/Unmarshaler、sql.Scanner/driver.Valuer），
+// 零值皆序列化為 null：
+//
+//	type Order struct {
+//	    CreatedAt timex.UnixMilliTime `json:"created_at"` // 1735689600000
+//	    ShipDate  timex.DateOnly      `json:"ship_date"`  // "2025-12-19"
+//	    UpdatedAt timex.RFC3339Milli  `json:"updated_at"` // "2025-12-19T10:30:00.000Z"
+//	}
+//
+// # 日曆差距
+//
+// 以日曆日（而非 Duration）計算年齡與日期差距，可正確處理閏日與夏令時間：
+//
+//	age := timex.Age(birth, time.Now(), loc)          // 足歲
+//	y, m, d := timex.DateDiff(a, b, loc)              // 1/31 → 2/28 = 0, 0, 28
+//	months := timex.MonthsBetween(a, b, loc)          // 完整月數
+//	days := timex.DaysBetween(a, b, loc)              // 跨越的日曆日數
+//
+// # 時間長度（含日、週）
+//
+// 解析與格式化支援 d（24h）與 w（7d）的時間長度；月、年非固定長度會被拒絕：
+//
+//	d, err := timex.ParseDurationExtended("1d12h30m")
+//	s := timex.FormatDurationExtended(36 * time.Hour) // "1d12h"
+//
+// # 顯示經過時間
+//
+// 適合 UI 顯示的格式（time.Duration.String 的 "1h23m45.678s" 不易閱讀）：
+//
+//	timex.FormatHMS(elapsed)     // "01:23:45"（超過一天時小時數累加，例如 "26:03:04"）
+//	timex.FormatDHMS(elapsed)    // "1d 02:03:04"
+//	timex.FormatCompact(elapsed) // "1h23m"（最大的兩個單位）
+//
+// # 到期判斷
+//
+// 統一的到期邊界語意（now ≥ expiresAt 即過期；零值 expiresAt 永不過期）：
+//
+//	exp := timex.ExpiresIn(15 * time.Minute)
+//	timex.IsExpired(exp)                    // false
+//	timex.TTL(exp)                          // 剩餘時間，過期後為 0
+//	timex.WithinGrace(exp, 5*time.Minute)   // 已過期但仍在寬限期內
+//
+// # 等待
+//
+// 可被 context 取消的等待（配合 graceful 關機）：
+//
+//	err := timex.SleepUntil(ctx, next)                      // ctx 取消時回傳 ctx.Err()
+//	err := timex.WaitForNextTick(ctx, 15*time.Minute, true)   // 等到下一個 :00/:15/:30/:45
+//
+// # 碼表
+//
+// 量測經過時間並記錄檢查點（monotonic clock，可併發 Lap）：
+//
+//	sw := timex.NewStopwatch()
+//	sw.Lap("query")
+//	sw.Lap("render")
+//	logger.Info("done", "timing", sw) // 結構化輸出 timing.total、timing.query ...
+//	fmt.Println(sw)                   // "total=1.23s query=0.4s render=0.83s"
+//
+// 透過 context 傳遞（middleware 啟動、handler 記錄）：
+//
+//	ctx = timex.WithStopwatch(ctx, timex.NewStopwatch())
+//	timex.StopwatchFromContext(ctx).Lap("db")
+//
+// # 時區
+//
+// 載入並快取時區（避免每次讀取 zoneinfo）：
+//
+//	loc, err := timex.LoadLocationCached("Asia/Taipei")
+//	loc := timex.MustLoadLocation("Asia/Taipei") // 初始化階段使用
+//
+// 轉換時區與驗證時區名稱：
+//
+//	t, err := timex.ConvertZone(t, "Asia/Taipei", "UTC")
+//	timex.IsValidTimezone("Europe/London") // true
+//
+// 在未安裝 tzdata 的精簡容器中，請匯入內嵌時區資料：
+//
+//	import _ "github.com/vincent119/commons/timex/tzdata"
 package timex
@@ -0,0 +1,170 @@
+package timex
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 擴充的時間單位（固定長度）。
+const (
+	Day  = 24 * time.Hour // 1d
+	Week = 7 * Day        // 1w
+)
+
+// ErrAmbiguousDurationUnit 表示使用了非固定長度的單位（月、年）。
+var ErrAmbiguousDurationUnit = errors.New("timex: ambiguous duration unit")
+
+// extendedUnits 為 ParseDurationExtended 支援的單位。
+var extendedUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond, // U+00B5
+	"μs": time.Microsecond, // U+03BC
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  Day,
+	"w":  Week,
+}
+
+// ambiguousUnits 為明確拒絕的非固定長度單位。
+var ambiguousUnits = map[string]bool{
+	"mo": true, "mon": true, "month": true, "months": true, "M": true,
+	"y": true, "yr": true, "year": true, "years": true,
+}
+
+// ParseDurationExtended 解析時間長度字串，除 time.ParseDuration 的單位外，另支援
+// d（= 24h）與 w（= 7d），可混合使用並支援負數，例如 "2d"、"1w"、"1d12h30m"、"-1.5d"。
+// 月、年非固定長度，會回傳 ErrAmbiguousDurationUnit。
+func ParseDurationExtended(s string) (time.Duration, error) {
+	orig := s
+	if s == "" {
+		return 0, fmt.Errorf("timex: invalid duration %q", orig)
+	}
+
+	neg := false
+	if s[0] == '-' || s[0] == '+' {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "0" {
+		return 0, nil
+	}
+	if s == "" {
+		return 0, fmt.Errorf("timex: invalid duration %q", orig)
+	}
+
+	var total uint64
+	for s != "" {
+		// 數值部分：整數與小數分開處理，整數部分以整數運算避免浮點誤差
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		whole := s[:i]
+		s = s[i:]
+
+		frac := ""
+		if s != "" && s[0] == '.' {
+			j := 1
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			frac = s[1:j]
+			s = s[j:]
+		}
+		if whole == "" && frac == "" {
+			return 0, fmt.Errorf("timex: invalid duration %q", orig)
+		}
+
+		// 單位部分
+		j := 0
+		for j < len(s) && s[j] != '.' && (s[j] < '0' || s[j] > '9') {
+			j++
+		}
+		unit := s[:j]
+		s = s[j:]
+
+		if ambiguousUnits[unit] {
+			return 0, fmt.Errorf("%w %q in %q: months and years are not fixed-length, use d or w instead", ErrAmbiguousDurationUnit, unit, orig)
+		}
+		mult, ok := extendedUnits[unit]
+		if !ok {
+			if unit == "" {
+				return 0, fmt.Errorf("timex: missing unit in duration %q", orig)
+			}
+			return 0, fmt.Errorf("timex: unknown unit %q in duration %q", unit, orig)
+		}
+
+		var v uint64
+		if whole != "" {
+			n, err := strconv.ParseUint(whole, 10, 64)
+			if err != nil || n > math.MaxInt64/uint64(mult) {
+				return 0, fmt.Errorf("timex: invalid duration %q: overflow", orig)
+			}
+			v = n * uint64(mult)
+		}
+		if frac != "" {
+			f, err := strconv.ParseFloat("0."+frac, 64)
+			if err != nil {
+				return 0, fmt.Errorf("timex: invalid duration %q", orig)
+			}
+			v += uint64(math.Round(f * float64(mult)))
+		}
+
+		total += v
+		if total > math.MaxInt64 {
+			return 0, fmt.Errorf("timex: invalid duration %q: overflow", orig)
+		}
+	}
+
+	d := time.Duration(total)
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// FormatDurationExtended 以最大的合適單位格式化時間長度（w、d、h、m，其餘交由 time.Duration.String），
+// 例如 36h → "1d12h"、8d → "1w1d"、90s → "1m30s"、1500ms → "1.5s"。
+// 輸出可由 ParseDurationExtended 解析還原。
+func FormatDurationExtended(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var b strings.Builder
+	// 以 uint64 取絕對值，避免 math.MinInt64 溢位
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+
+	for _, unit := range []struct {
+		suffix string
+		size   time.Duration
+	}{
+		{"w", Week},
+		{"d", Day},
+		{"h", time.Hour},
+		{"m", time.Minute},
+	} {
+		if n := u / uint64(unit.size); n > 0 {
+			b.WriteString(strconv.FormatUint(n, 10))
+			b.WriteString(unit.suffix)
+			u -= n * uint64(unit.size)
+		}
+	}
+
+	// 不足一分鐘的部分（例如 30s、1.5s、500ms）
+	if u > 0 {
+		b.WriteString(time.Duration(u).String())
+	}
+	return b.String()
+}
@@ -0,0 +1,130 @@
+package timex
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDurationExtended(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"0", 0},
+		{"2d", 48 * time.Hour},
+		{"1w", 7 * 24 * time.Hour},
+		{"1d12h30m", 36*time.Hour + 30*time.Minute},
+		{"1w2d3h", 9*24*time.Hour + 3*time.Hour},
+		{"-1d", -24 * time.Hour},
+		{"+1h", time.Hour},
+		{"1.5d", 36 * time.Hour},
+		{"90s", 90 * time.Second},
+		{"1h30m15s500ms", time.Hour + 30*time.Minute + 15*time.Second + 500*time.Millisecond},
+		{"10us", 10 * time.Microsecond},
+		{"10µs", 10 * time.Microsecond},
+		{"100ns", 100 * time.Nanosecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDurationExtended(tt.in)
+			if err != nil {
+				t.Fatalf("ParseDurationExtended(%q) error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDurationExtended(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDurationExtended_MatchesStdlib(t *testing.T) {
+	for _, s := range []string{"1h", "1.5h", "-2m3s", "300ms", "1h2m3s4ms5us6ns"} {
+		want, _ := time.ParseDuration(s)
+		got, err := ParseDurationExtended(s)
+		if err != nil || got != want {
+			t.Errorf("ParseDurationExtended(%q) = %v, %v; want %v", s, got, err, want)
+		}
+	}
+}
+
+func TestParseDurationExtended_Errors(t *testing.T) {
+	tests := []struct {
+		in        string
+		ambiguous bool
+		wantMsg   string
+	}{
+		{"", false, "invalid duration"},
+		{"-", false, "invalid duration"},
+		{"d", false, "invalid duration"},
+		{"10", false, "missing unit"},
+		{"1x", false, "unknown unit"},
+		{"1..5h", false, "missing unit"},
+		{"1mo", true, "not fixed-length"},
+		{"2y", true, "not fixed-length"},
+		{"1M", true, "not fixed-length"},
+		{"1d2months", true, "not fixed-length"},
+		{"100000000w", false, "overflow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := ParseDurationExtended(tt.in)
+			if err == nil {
+				t.Fatalf("ParseDurationExtended(%q) should fail", tt.in)
+			}
+			if errors.Is(err, ErrAmbiguousDurationUnit) != tt.ambiguous {
+				t.Errorf("errors.Is(ErrAmbiguousDurationUnit) = %v, want %v (err=%v)", !tt.ambiguous, tt.ambiguous, err)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("error = %q, want to contain %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestFormatDurationExtended(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{36 * time.Hour, "1d12h"},
+		{8 * 24 * time.Hour, "1w1d"},
+		{14 * 24 * time.Hour, "2w"},
+		{90 * time.Second, "1m30s"},
+		{time.Hour + 30*time.Minute, "1h30m"},
+		{1500 * time.Millisecond, "1.5s"},
+		{500 * time.Millisecond, "500ms"},
+		{-36 * time.Hour, "-1d12h"},
+		{24*time.Hour + time.Second, "1d1s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatDurationExtended(tt.in); got != tt.want {
+				t.Errorf("FormatDurationExtended(%v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDurationExtended_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for range 1000 {
+		// 秒級精度，範圍約 ±10 年
+		d := time.Duration(r.Int63n(2*10*365*24*3600)-10*365*24*3600) * time.Second
+
+		s := FormatDurationExtended(d)
+		got, err := ParseDurationExtended(s)
+		if err != nil {
+			t.Fatalf("ParseDurationExtended(%q) error: %v", s, err)
+		}
+		if got != d {
+			t.Fatalf("round trip mismatch: %v -> %q -> %v", d, s, got)
+		}
+	}
+}
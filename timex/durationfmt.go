@@ -0,0 +1,118 @@
+package timex
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatHMS 以 "HH:MM:SS" 格式化時間長度，適合顯示經過時間；不足一秒的部分捨去，
+// 超過一天時小時數持續累加而不換算為日，負數加上 "-" 前綴：
+//
+//	timex.FormatHMS(time.Hour + 23*time.Minute + 45*time.Second) // "01:23:45"
+//	timex.FormatHMS(26 * time.Hour)                              // "26:00:00"
+//	timex.FormatHMS(1500 * time.Millisecond)                     // "00:00:01"
+func FormatHMS(d time.Duration) string {
+	return formatClock(d, false)
+}
+
+// FormatDHMS 同 FormatHMS，但滿一天的部分以 "Nd " 前綴表示，不足一天時與 FormatHMS 相同：
+//
+//	timex.FormatDHMS(26*time.Hour + 3*time.Minute + 4*time.Second) // "1d 02:03:04"
+//	timex.FormatDHMS(90 * time.Minute)                             // "01:30:00"
+func FormatDHMS(d time.Duration) string {
+	return formatClock(d, true)
+}
+
+// FormatCompact 以最大的兩個單位（d、h、m、s）精簡格式化時間長度，其餘部分捨去，
+// 適合在 UI 顯示大約的經過時間；第二個單位為 0 時省略，不足一秒時以毫秒表示：
+//
+//	timex.FormatCompact(time.Hour + 23*time.Minute + 45*time.Second) // "1h23m"
+//	timex.FormatCompact(50 * time.Hour)                              // "2d2h"
+//	timex.FormatCompact(time.Hour + 30*time.Second)                  // "1h"
+//	timex.FormatCompact(350 * time.Millisecond)                      // "350ms"
+//
+// 與 FormatDurationExtended 不同，輸出會遺失精度，不適合用於儲存或設定檔。
+func FormatCompact(d time.Duration) string {
+	var b strings.Builder
+	// 以 uint64 取絕對值，避免 math.MinInt64 溢位
+	u := uint64(d)
+	if d < 0 {
+		u = -u
+	}
+	if u < uint64(time.Second) {
+		ms := u / uint64(time.Millisecond)
+		if ms == 0 {
+			return "0s"
+		}
+		if d < 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(strconv.FormatUint(ms, 10))
+		b.WriteString("ms")
+		return b.String()
+	}
+
+	if d < 0 {
+		b.WriteByte('-')
+	}
+	units := []struct {
+		suffix string
+		size   time.Duration
+	}{
+		{"d", Day},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	}
+	for i, unit := range units {
+		n := u / uint64(unit.size)
+		if n == 0 {
+			continue
+		}
+		b.WriteString(strconv.FormatUint(n, 10))
+		b.WriteString(unit.suffix)
+		if i+1 < len(units) {
+			next := units[i+1]
+			if m := u % uint64(unit.size) / uint64(next.size); m > 0 {
+				b.WriteString(strconv.FormatUint(m, 10))
+				b.WriteString(next.suffix)
+			}
+		}
+		break
+	}
+	return b.String()
+}
+
+// formatClock 格式化為 [Nd ]HH:MM:SS；withDays 為 false 時小時數不換算為日。
+func formatClock(d time.Duration, withDays bool) string {
+	u := uint64(d)
+	if d < 0 {
+		u = -u
+	}
+	secs := u / uint64(time.Second)
+
+	var b strings.Builder
+	if d < 0 && secs > 0 {
+		b.WriteByte('-')
+	}
+	if days := secs / 86400; withDays && days > 0 {
+		b.WriteString(strconv.FormatUint(days, 10))
+		b.WriteString("d ")
+		secs -= days * 86400
+	}
+	writeTwoDigits(&b, secs/3600)
+	b.WriteByte(':')
+	writeTwoDigits(&b, secs/60%60)
+	b.WriteByte(':')
+	writeTwoDigits(&b, secs%60)
+	return b.String()
+}
+
+// writeTwoDigits 寫入至少兩位數（不足補 0）的十進位數字。
+func writeTwoDigits(b *strings.Builder, n uint64) {
+	if n < 10 {
+		b.WriteByte('0')
+	}
+	b.WriteString(strconv.FormatUint(n, 10))
+}
@@ -0,0 +1,68 @@
+package timex
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFormatHMS(t *testing.T) {
+	tests := []struct {
+		name string
+		in   time.Duration
+		hms  string
+		dhms string
+	}{
+		{"zero", 0, "00:00:00", "00:00:00"},
+		{"sub_second", 999 * time.Millisecond, "00:00:00", "00:00:00"},
+		{"truncate_fraction", 1500 * time.Millisecond, "00:00:01", "00:00:01"},
+		{"one_hour", time.Hour, "01:00:00", "01:00:00"},
+		{"mixed", time.Hour + 23*time.Minute + 45*time.Second, "01:23:45", "01:23:45"},
+		{"one_day", Day, "24:00:00", "1d 00:00:00"},
+		{"multi_day", 26*time.Hour + 3*time.Minute + 4*time.Second, "26:03:04", "1d 02:03:04"},
+		{"many_days", 100*Day + 5*time.Second, "2400:00:05", "100d 00:00:05"},
+		{"negative", -(90*time.Minute + 5*time.Second), "-01:30:05", "-01:30:05"},
+		{"negative_sub_second", -500 * time.Millisecond, "00:00:00", "00:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatHMS(tt.in); got != tt.hms {
+				t.Errorf("FormatHMS(%v) = %q, want %q", tt.in, got, tt.hms)
+			}
+			if got := FormatDHMS(tt.in); got != tt.dhms {
+				t.Errorf("FormatDHMS(%v) = %q, want %q", tt.in, got, tt.dhms)
+			}
+		})
+	}
+}
+
+func TestFormatCompact(t *testing.T) {
+	tests := []struct {
+		name string
+		in   time.Duration
+		want string
+	}{
+		{"zero", 0, "0s"},
+		{"sub_millisecond", 500 * time.Microsecond, "0s"},
+		{"sub_second", 350 * time.Millisecond, "350ms"},
+		{"seconds", 45*time.Second + 900*time.Millisecond, "45s"},
+		{"minutes", 90 * time.Second, "1m30s"},
+		{"one_hour", time.Hour, "1h"},
+		{"hours_minutes", time.Hour + 23*time.Minute + 45*time.Second, "1h23m"},
+		{"skip_zero_second_unit", time.Hour + 30*time.Second, "1h"},
+		{"multi_day", 50*time.Hour + 59*time.Minute, "2d2h"},
+		{"exact_days", 3 * Day, "3d"},
+		{"negative", -(time.Hour + 23*time.Minute), "-1h23m"},
+		{"negative_sub_second", -350 * time.Millisecond, "-350ms"},
+		{"min_int64", math.MinInt64, "-106751d23h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatCompact(tt.in); got != tt.want {
+				t.Errorf("FormatCompact(%v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
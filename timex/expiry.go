@@ -0,0 +1,59 @@
+package timex
+
+import (
+	"math"
+	"time"
+)
+
+// NeverExpires 為 TTL 對「永不過期」（零值 expiresAt）回傳的最大 Duration。
+const NeverExpires = time.Duration(math.MaxInt64)
+
+// 過期判斷的邊界語意統一如下：
+//   - now ≥ expiresAt 即視為已過期（到期時刻當下已過期）
+//   - 零值 expiresAt 視為「永不過期」（例如未設定到期時間的 token）
+//   - now 參數可省略（預設 time.Now()），方便測試時注入固定時間
+
+// pickNow 回傳可選的 now 參數，未提供時使用 time.Now()。
+func pickNow(now []time.Time) time.Time {
+	if len(now) > 0 {
+		return now[0]
+	}
+	return time.Now()
+}
+
+// IsZeroExpiry 判斷 expiresAt 是否為零值（代表永不過期）。
+func IsZeroExpiry(expiresAt time.Time) bool {
+	return expiresAt.IsZero()
+}
+
+// IsExpired 判斷是否已過期（now ≥ expiresAt）。零值 expiresAt 永不過期。
+func IsExpired(expiresAt time.Time, now ...time.Time) bool {
+	if IsZeroExpiry(expiresAt) {
+		return false
+	}
+	return !pickNow(now).Before(expiresAt)
+}
+
+// TTL 回傳距離過期的剩餘時間，已過期時回傳 0（不會為負數）。
+// 零值 expiresAt 回傳 NeverExpires。
+func TTL(expiresAt time.Time, now ...time.Time) time.Duration {
+	if IsZeroExpiry(expiresAt) {
+		return NeverExpires
+	}
+	return max(expiresAt.Sub(pickNow(now)), 0)
+}
+
+// ExpiresIn 回傳從現在起經過 d 後的到期時間（UTC）。
+func ExpiresIn(d time.Duration) time.Time {
+	return NowUTC().Add(d)
+}
+
+// WithinGrace 判斷是否「已過期但仍在寬限期內」（expiresAt ≤ now < expiresAt + grace），
+// 適用於 token 過期後仍允許刷新的情境。尚未過期或零值 expiresAt 回傳 false。
+func WithinGrace(expiresAt time.Time, grace time.Duration, now ...time.Time) bool {
+	n := pickNow(now)
+	if !IsExpired(expiresAt, n) {
+		return false
+	}
+	return n.Before(expiresAt.Add(grace))
+}
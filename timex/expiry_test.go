@@ -0,0 +1,108 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsExpired(t *testing.T) {
+	exp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		now       time.Time
+		want      bool
+	}{
+		{"before", exp, exp.Add(-time.Nanosecond), false},
+		{"exact_boundary", exp, exp, true},
+		{"after", exp, exp.Add(time.Second), true},
+		{"zero_never_expires", time.Time{}, exp, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsExpired(tt.expiresAt, tt.now); got != tt.want {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// 未提供 now 時使用目前時間
+	if !IsExpired(time.Now().Add(-time.Minute)) {
+		t.Error("IsExpired() should use time.Now() by default")
+	}
+	if IsExpired(time.Now().Add(time.Minute)) {
+		t.Error("IsExpired() should be false for future expiry")
+	}
+}
+
+func TestTTL(t *testing.T) {
+	exp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		now       time.Time
+		want      time.Duration
+	}{
+		{"remaining", exp, exp.Add(-time.Minute), time.Minute},
+		{"exact_boundary", exp, exp, 0},
+		{"expired_clamped", exp, exp.Add(time.Hour), 0},
+		{"zero_never_expires", time.Time{}, exp, NeverExpires},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TTL(tt.expiresAt, tt.now); got != tt.want {
+				t.Errorf("TTL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpiresIn(t *testing.T) {
+	before := time.Now()
+	got := ExpiresIn(time.Hour)
+	after := time.Now()
+
+	if got.Location() != time.UTC {
+		t.Error("ExpiresIn() should return UTC")
+	}
+	if got.Before(before.Add(time.Hour)) || got.After(after.Add(time.Hour)) {
+		t.Errorf("ExpiresIn(1h) = %v, want between %v and %v", got, before.Add(time.Hour), after.Add(time.Hour))
+	}
+}
+
+func TestWithinGrace(t *testing.T) {
+	exp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	grace := 5 * time.Minute
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		now       time.Time
+		want      bool
+	}{
+		{"not_expired", exp, exp.Add(-time.Second), false},
+		{"at_expiry", exp, exp, true},
+		{"inside_grace", exp, exp.Add(time.Minute), true},
+		{"grace_end_exclusive", exp, exp.Add(grace), false},
+		{"after_grace", exp, exp.Add(time.Hour), false},
+		{"zero_never_expires", time.Time{}, exp, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithinGrace(tt.expiresAt, grace, tt.now); got != tt.want {
+				t.Errorf("WithinGrace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsZeroExpiry(t *testing.T) {
+	if !IsZeroExpiry(time.Time{}) {
+		t.Error("IsZeroExpiry(zero) should be true")
+	}
+	if IsZeroExpiry(time.Now()) {
+		t.Error("IsZeroExpiry(now) should be false")
+	}
+}
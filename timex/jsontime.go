@@ -0,0 +1,280 @@
+package timex
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// jsonNull 為 JSON null 字面值。
+var jsonNull = []byte("null")
+
+// =============================================================================
+// UnixMilliTime
+// =============================================================================
+
+// UnixMilliTime 以 Unix 毫秒數（JSON number）序列化的時間型別。
+//   - Marshal：零值輸出 null，其餘輸出毫秒數（例如 1735689600000）
+//   - Unmarshal：接受 null、毫秒數或 RFC 3339 字串
+//   - DB：Value 輸出 time.Time；Scan 接受 time.Time、int64（毫秒）與 RFC 3339 字串
+type UnixMilliTime time.Time
+
+// ToTime 轉回 time.Time。
+func (u UnixMilliTime) ToTime() time.Time { return time.Time(u) }
+
+// FromTime 以 time.Time 設定值。
+func (u *UnixMilliTime) FromTime(t time.Time) { *u = UnixMilliTime(t) }
+
+// MarshalJSON 實作 json.Marshaler。
+func (u UnixMilliTime) MarshalJSON() ([]byte, error) {
+	t := time.Time(u)
+	if t.IsZero() {
+		return jsonNull, nil
+	}
+	return strconv.AppendInt(nil, t.UnixMilli(), 10), nil
+}
+
+// UnmarshalJSON 實作 json.Unmarshaler。
+func (u *UnixMilliTime) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, jsonNull) {
+		*u = UnixMilliTime{}
+		return nil
+	}
+
+	// 字串形式：RFC 3339
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("timex: UnixMilliTime: invalid JSON string %s: %w", data, err)
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return fmt.Errorf("timex: UnixMilliTime: invalid RFC 3339 time %q: %w", s, err)
+		}
+		*u = UnixMilliTime(t)
+		return nil
+	}
+
+	// 數字形式：Unix 毫秒
+	ms, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("timex: UnixMilliTime: invalid unix millis %s: %w", data, err)
+	}
+	*u = UnixMilliTime(time.UnixMilli(ms).UTC())
+	return nil
+}
+
+// Value 實作 driver.Valuer，零值寫入 NULL。
+func (u UnixMilliTime) Value() (driver.Value, error) {
+	return timeValue(time.Time(u)), nil
+}
+
+// Scan 實作 sql.Scanner。
+func (u *UnixMilliTime) Scan(src any) error {
+	if ms, ok := src.(int64); ok {
+		*u = UnixMilliTime(time.UnixMilli(ms).UTC())
+		return nil
+	}
+	t, err := scanTime(src, time.RFC3339Nano)
+	if err != nil {
+		return fmt.Errorf("timex: UnixMilliTime: %w", err)
+	}
+	*u = UnixMilliTime(t)
+	return nil
+}
+
+// =============================================================================
+// DateOnly
+// =============================================================================
+
+// DateOnly 以 "2006-01-02" 序列化的日期型別（依時間本身的時區取日期）。
+//   - Marshal：零值輸出 null，其餘輸出 "2006-01-02"
+//   - Unmarshal：接受 null、空字串或 "2006-01-02"（解析為 UTC 零點）
+//   - DB：Value 輸出當日 UTC 零點的 time.Time；Scan 接受 time.Time 與 "2006-01-02" 字串
+type DateOnly time.Time
+
+// ToTime 轉回 time.Time。
+func (d DateOnly) ToTime() time.Time { return time.Time(d) }
+
+// FromTime 以 time.Time 設定值。
+func (d *DateOnly) FromTime(t time.Time) { *d = DateOnly(t) }
+
+// String 回傳 "2006-01-02" 格式字串，零值回傳空字串。
+func (d DateOnly) String() string {
+	t := time.Time(d)
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(LayoutDate)
+}
+
+// MarshalJSON 實作 json.Marshaler。
+func (d DateOnly) MarshalJSON() ([]byte, error) {
+	if time.Time(d).IsZero() {
+		return jsonNull, nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON 實作 json.Unmarshaler。
+func (d *DateOnly) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, jsonNull) {
+		*d = DateOnly{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("timex: DateOnly: expected JSON string, got %s", data)
+	}
+	if s == "" {
+		*d = DateOnly{}
+		return nil
+	}
+
+	t, err := time.Parse(LayoutDate, s)
+	if err != nil {
+		return fmt.Errorf("timex: DateOnly: invalid date %q (want YYYY-MM-DD): %w", s, err)
+	}
+	*d = DateOnly(t)
+	return nil
+}
+
+// Value 實作 driver.Valuer，零值寫入 NULL。
+func (d DateOnly) Value() (driver.Value, error) {
+	t := time.Time(d)
+	if t.IsZero() {
+		return nil, nil
+	}
+	y, m, day := t.Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, time.UTC), nil
+}
+
+// Scan 實作 sql.Scanner。
+func (d *DateOnly) Scan(src any) error {
+	t, err := scanTime(src, LayoutDate)
+	if err != nil {
+		return fmt.Errorf("timex: DateOnly: %w", err)
+	}
+	*d = DateOnly(t)
+	return nil
+}
+
+// =============================================================================
+// RFC3339Milli
+// =============================================================================
+
+// RFC3339Milli 以固定三位毫秒、UTC 的 RFC 3339 字串序列化的時間型別
+// （例如 "2025-12-19T10:30:00.000Z"）。
+//   - Marshal：零值輸出 null
+//   - Unmarshal：接受 null、空字串或任意 RFC 3339 字串
+//   - DB：Value 輸出 UTC time.Time；Scan 接受 time.Time 與 RFC 3339 字串
+type RFC3339Milli time.Time
+
+// ToTime 轉回 time.Time。
+func (r RFC3339Milli) ToTime() time.Time { return time.Time(r) }
+
+// FromTime 以 time.Time 設定值。
+func (r *RFC3339Milli) FromTime(t time.Time) { *r = RFC3339Milli(t) }
+
+// String 回傳 LayoutUTCMilli 格式字串，零值回傳空字串。
+func (r RFC3339Milli) String() string {
+	t := time.Time(r)
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(LayoutUTCMilli)
+}
+
+// MarshalJSON 實作 json.Marshaler。
+func (r RFC3339Milli) MarshalJSON() ([]byte, error) {
+	if time.Time(r).IsZero() {
+		return jsonNull, nil
+	}
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON 實作 json.Unmarshaler。
+func (r *RFC3339Milli) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, jsonNull) {
+		*r = RFC3339Milli{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("timex: RFC3339Milli: expected JSON string, got %s", data)
+	}
+	if s == "" {
+		*r = RFC3339Milli{}
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return fmt.Errorf("timex: RFC3339Milli: invalid RFC 3339 time %q: %w", s, err)
+	}
+	*r = RFC3339Milli(t.UTC())
+	return nil
+}
+
+// Value 實作 driver.Valuer，零值寫入 NULL。
+func (r RFC3339Milli) Value() (driver.Value, error) {
+	return timeValue(time.Time(r)), nil
+}
+
+// Scan 實作 sql.Scanner。
+func (r *RFC3339Milli) Scan(src any) error {
+	t, err := scanTime(src, time.RFC3339Nano)
+	if err != nil {
+		return fmt.Errorf("timex: RFC3339Milli: %w", err)
+	}
+	*r = RFC3339Milli(t.UTC())
+	return nil
+}
+
+// =============================================================================
+// 共用工具
+// =============================================================================
+
+// timeValue 將時間轉為 driver.Value，零值轉為 nil（NULL）。
+func timeValue(t time.Time) driver.Value {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC()
+}
+
+// scanTime 將 DB 欄位值轉為 time.Time，字串以 layout 解析；nil 轉為零值。
+func scanTime(src any, layout string) (time.Time, error) {
+	switch v := src.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case []byte:
+		return parseScanned(string(v), layout)
+	case string:
+		return parseScanned(v, layout)
+	default:
+		return time.Time{}, fmt.Errorf("cannot scan %T", src)
+	}
+}
+
+// parseScanned 解析 DB 讀出的時間字串，空字串視為零值。
+func parseScanned(s, layout string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse %q: %w", s, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,248 @@
+package timex
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+type jsonModel struct {
+	Millis UnixMilliTime `json:"millis"`
+	Date   DateOnly      `json:"date"`
+	Stamp  RFC3339Milli  `json:"stamp"`
+}
+
+func TestJSONTime_Marshal(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Taipei")
+	in := time.Date(2025, 1, 1, 8, 30, 0, 120_000_000, loc) // 2025-01-01 00:30:00.12 UTC
+
+	m := jsonModel{
+		Millis: UnixMilliTime(in),
+		Date:   DateOnly(in),
+		Stamp:  RFC3339Milli(in),
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+
+	want := `{"millis":1735691400120,"date":"2025-01-01","stamp":"2025-01-01T00:30:00.120Z"}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func TestJSONTime_MarshalZero(t *testing.T) {
+	b, err := json.Marshal(jsonModel{})
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+	want := `{"millis":null,"date":null,"stamp":null}`
+	if string(b) != want {
+		t.Errorf("json = %s, want %s", b, want)
+	}
+}
+
+func TestRFC3339Milli_AlwaysThreeDigits(t *testing.T) {
+	in := time.Date(2025, 12, 19, 10, 30, 0, 0, time.UTC)
+	b, _ := json.Marshal(RFC3339Milli(in))
+	if string(b) != `"2025-12-19T10:30:00.000Z"` {
+		t.Errorf("json = %s", b)
+	}
+}
+
+func TestUnixMilliTime_Unmarshal(t *testing.T) {
+	want := time.Date(2025, 1, 1, 0, 30, 0, 120_000_000, time.UTC)
+	tests := []struct {
+		name string
+		in   string
+		want time.Time
+	}{
+		{"number", `1735691400120`, want},
+		{"rfc3339_string", `"2025-01-01T08:30:00.12+08:00"`, want},
+		{"null", `null`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u UnixMilliTime
+			if err := json.Unmarshal([]byte(tt.in), &u); err != nil {
+				t.Fatalf("Unmarshal(%s) error: %v", tt.in, err)
+			}
+			if !u.ToTime().Equal(tt.want) {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, u.ToTime(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDateOnly_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want time.Time
+	}{
+		{"date", `"2025-12-19"`, time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC)},
+		{"null", `null`, time.Time{}},
+		{"empty_string", `""`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d DateOnly
+			if err := json.Unmarshal([]byte(tt.in), &d); err != nil {
+				t.Fatalf("Unmarshal(%s) error: %v", tt.in, err)
+			}
+			if !d.ToTime().Equal(tt.want) {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, d.ToTime(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRFC3339Milli_Unmarshal(t *testing.T) {
+	var r RFC3339Milli
+	if err := json.Unmarshal([]byte(`"2025-12-19T18:30:00.5+08:00"`), &r); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	want := time.Date(2025, 12, 19, 10, 30, 0, 500_000_000, time.UTC)
+	if !r.ToTime().Equal(want) || r.ToTime().Location() != time.UTC {
+		t.Errorf("Unmarshal = %v, want %v", r.ToTime(), want)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &r); err != nil || !r.ToTime().IsZero() {
+		t.Errorf("Unmarshal(null) = %v, %v; want zero", r.ToTime(), err)
+	}
+}
+
+func TestJSONTime_UnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  json.Unmarshaler
+		in      string
+		wantMsg string
+	}{
+		{"millis_bool", new(UnixMilliTime), `true`, "timex: UnixMilliTime: invalid unix millis"},
+		{"millis_float", new(UnixMilliTime), `1.5`, "timex: UnixMilliTime: invalid unix millis"},
+		{"millis_bad_string", new(UnixMilliTime), `"yesterday"`, "timex: UnixMilliTime: invalid RFC 3339 time"},
+		{"date_number", new(DateOnly), `20251219`, "timex: DateOnly: expected JSON string"},
+		{"date_bad_format", new(DateOnly), `"2025/12/19"`, "timex: DateOnly: invalid date"},
+		{"date_with_time", new(DateOnly), `"2025-12-19T00:00:00Z"`, "timex: DateOnly: invalid date"},
+		{"stamp_number", new(RFC3339Milli), `123`, "timex: RFC3339Milli: expected JSON string"},
+		{"stamp_bad_format", new(RFC3339Milli), `"2025-12-19 10:30:00"`, "timex: RFC3339Milli: invalid RFC 3339 time"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := json.Unmarshal([]byte(tt.in), tt.target)
+			if err == nil {
+				t.Fatalf("Unmarshal(%s) should fail", tt.in)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("error = %q, want to contain %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestJSONTime_RoundTrip(t *testing.T) {
+	in := jsonModel{
+		Millis: UnixMilliTime(time.UnixMilli(1735691400120).UTC()),
+		Date:   DateOnly(time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC)),
+		Stamp:  RFC3339Milli(time.Date(2025, 12, 19, 10, 30, 0, 123_000_000, time.UTC)),
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal error: %v", err)
+	}
+
+	var out jsonModel
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if !out.Millis.ToTime().Equal(in.Millis.ToTime()) ||
+		!out.Date.ToTime().Equal(in.Date.ToTime()) ||
+		!out.Stamp.ToTime().Equal(in.Stamp.ToTime()) {
+		t.Errorf("round trip mismatch: got %+v, want %+v", out, in)
+	}
+}
+
+func TestJSONTime_FromTime(t *testing.T) {
+	in := time.Date(2025, 12, 19, 10, 30, 0, 0, time.UTC)
+
+	var u UnixMilliTime
+	u.FromTime(in)
+	var d DateOnly
+	d.FromTime(in)
+	var r RFC3339Milli
+	r.FromTime(in)
+
+	if !u.ToTime().Equal(in) || !d.ToTime().Equal(in) || !r.ToTime().Equal(in) {
+		t.Error("FromTime/ToTime should round trip")
+	}
+	if d.String() != "2025-12-19" {
+		t.Errorf("DateOnly.String() = %q", d.String())
+	}
+}
+
+func TestJSONTime_Value(t *testing.T) {
+	in := time.Date(2025, 12, 19, 18, 30, 0, 0, time.FixedZone("UTC+8", 8*3600))
+
+	v, err := UnixMilliTime(in).Value()
+	if err != nil || !v.(time.Time).Equal(in) {
+		t.Errorf("UnixMilliTime.Value() = %v, %v", v, err)
+	}
+
+	v, err = DateOnly(in).Value()
+	if err != nil || !v.(time.Time).Equal(time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("DateOnly.Value() = %v, %v", v, err)
+	}
+
+	v, err = RFC3339Milli(in).Value()
+	if err != nil || v.(time.Time).Location() != time.UTC {
+		t.Errorf("RFC3339Milli.Value() = %v, %v", v, err)
+	}
+
+	// 零值寫入 NULL
+	if v, _ := (UnixMilliTime{}).Value(); v != nil {
+		t.Errorf("zero UnixMilliTime.Value() = %v, want nil", v)
+	}
+	if v, _ := (DateOnly{}).Value(); v != nil {
+		t.Errorf("zero DateOnly.Value() = %v, want nil", v)
+	}
+	if v, _ := (RFC3339Milli{}).Value(); v != nil {
+		t.Errorf("zero RFC3339Milli.Value() = %v, want nil", v)
+	}
+}
+
+func TestJSONTime_Scan(t *testing.T) {
+	ts := time.Date(2025, 12, 19, 10, 30, 0, 0, time.UTC)
+
+	var u UnixMilliTime
+	if err := u.Scan(ts.UnixMilli()); err != nil || !u.ToTime().Equal(ts) {
+		t.Errorf("UnixMilliTime.Scan(int64) = %v, %v", u.ToTime(), err)
+	}
+	if err := u.Scan(ts); err != nil || !u.ToTime().Equal(ts) {
+		t.Errorf("UnixMilliTime.Scan(time) = %v, %v", u.ToTime(), err)
+	}
+	if err := u.Scan(nil); err != nil || !u.ToTime().IsZero() {
+		t.Errorf("UnixMilliTime.Scan(nil) = %v, %v", u.ToTime(), err)
+	}
+
+	var d DateOnly
+	if err := d.Scan([]byte("2025-12-19")); err != nil || d.String() != "2025-12-19" {
+		t.Errorf("DateOnly.Scan([]byte) = %v, %v", d, err)
+	}
+	if err := d.Scan("bad"); err == nil || !strings.Contains(err.Error(), "timex: DateOnly") {
+		t.Errorf("DateOnly.Scan(bad) error = %v", err)
+	}
+
+	var r RFC3339Milli
+	if err := r.Scan("2025-12-19T18:30:00+08:00"); err != nil || !r.ToTime().Equal(ts) {
+		t.Errorf("RFC3339Milli.Scan(string) = %v, %v", r.ToTime(), err)
+	}
+	if err := r.Scan(3.14); err == nil {
+		t.Error("RFC3339Milli.Scan(float64) should fail")
+	}
+}
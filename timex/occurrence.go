@@ -0,0 +1,64 @@
+package timex
+
+import "time"
+
+// NextOccurrence 回傳嚴格晚於 now、且 loc 時區當地牆上時間為 hour:min:sec 的下一個時刻（UTC）。
+// 適用於每日排程（例如「下一個台北時間 02:30」）；now 恰為該時刻時回傳隔天。
+//
+// 夏令時間邊界：
+//   - 當地時間不存在（春季跳時）：改為跳時後第一個有效時刻，例如 America/New_York 2025-03-09
+//     的 02:30 不存在，回傳 03:00 EDT
+//   - 當地時間重複（秋季回撥）：只取第一次出現的時刻，同一天不會觸發兩次
+//
+// loc 為 nil 時視為 UTC。
+func NextOccurrence(now time.Time, hour, min, sec int, loc *time.Location) time.Time {
+	return nextOccurrence(now, hour, min, sec, loc, func(time.Weekday) bool { return true })
+}
+
+// NextWeekdayOccurrence 同 NextOccurrence，但只匹配 loc 時區中星期為 wd 的日期，適用於每週排程。loc 為 nil 時視為 UTC。
+func NextWeekdayOccurrence(now time.Time, wd time.Weekday, hour, min, sec int, loc *time.Location) time.Time {
+	return nextOccurrence(now, hour, min, sec, loc, func(w time.Weekday) bool { return w == wd })
+}
+
+// nextOccurrence 從 now 的當地日期起逐日尋找符合 match 且晚於 now 的時刻（最多 8 天即可涵蓋每週排程）。
+func nextOccurrence(now time.Time, hour, min, sec int, loc *time.Location, match func(time.Weekday) bool) time.Time {
+	loc = locationOrUTC(loc)
+	y, m, d := now.In(loc).Date()
+	clock := time.Date(0, 1, 1, hour, min, sec, 0, time.UTC)
+
+	for i := 0; ; i++ {
+		date := time.Date(y, m, d+i, 0, 0, 0, 0, time.UTC)
+		if !match(date.Weekday()) {
+			continue
+		}
+		t := resolveWallClock(date.Year(), date.Month(), date.Day(), clock, loc)
+		if t.Hour() != hour || t.Minute() != min || t.Second() != sec {
+			// resolveWallClock 將跳時區間內的時間往後推移，改為跳時結束的時刻
+			t = zoneTransitionBefore(t)
+		}
+		if t.After(now) {
+			return t.UTC()
+		}
+	}
+}
+
+// zoneTransitionBefore 回傳 t 之前 24 小時內最近一次偏移量切換的時刻（切換後的第一個時刻）。
+// 若該區間內沒有切換則回傳 t。
+func zoneTransitionBefore(t time.Time) time.Time {
+	_, off := t.Zone()
+	lo, hi := t.Add(-24*time.Hour), t
+	if _, o := lo.Zone(); o == off {
+		return t
+	}
+
+	// 二分搜尋，時區切換皆發生在整秒
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		if _, o := mid.Zone(); o == off {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi.Truncate(time.Second)
+}
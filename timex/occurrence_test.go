@@ -0,0 +1,113 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextOccurrence(t *testing.T) {
+	taipei := mustLoad(t, "Asia/Taipei")
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		h, m, s int
+		loc     *time.Location
+		want    time.Time
+	}{
+		{"later_today", time.Date(2025, 6, 10, 1, 0, 0, 0, taipei), 2, 30, 0, taipei,
+			time.Date(2025, 6, 10, 2, 30, 0, 0, taipei)},
+		{"exactly_now_is_tomorrow", time.Date(2025, 6, 10, 2, 30, 0, 0, taipei), 2, 30, 0, taipei,
+			time.Date(2025, 6, 11, 2, 30, 0, 0, taipei)},
+		{"just_before", time.Date(2025, 6, 10, 2, 29, 59, 999, taipei), 2, 30, 0, taipei,
+			time.Date(2025, 6, 10, 2, 30, 0, 0, taipei)},
+		{"passed_today", time.Date(2025, 6, 10, 3, 0, 0, 0, taipei), 2, 30, 0, taipei,
+			time.Date(2025, 6, 11, 2, 30, 0, 0, taipei)},
+		{"year_end", time.Date(2025, 12, 31, 23, 0, 0, 0, taipei), 2, 30, 0, taipei,
+			time.Date(2026, 1, 1, 2, 30, 0, 0, taipei)},
+		// now 以其他時區表示，仍以 loc 的當地日期計算
+		{"now_in_utc", time.Date(2025, 6, 9, 17, 0, 0, 0, time.UTC), 2, 30, 0, taipei,
+			time.Date(2025, 6, 10, 2, 30, 0, 0, taipei)},
+
+		// 2025-03-09 02:00 EST → 03:00 EDT：02:30 不存在，取跳時後第一個時刻 03:00 EDT
+		{"spring_forward_gap", time.Date(2025, 3, 8, 23, 0, 0, 0, ny), 2, 30, 0, ny,
+			time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC)},
+		{"after_gap_next_day", time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC), 2, 30, 0, ny,
+			time.Date(2025, 3, 10, 6, 30, 0, 0, time.UTC)},
+		{"spring_forward_unaffected", time.Date(2025, 3, 8, 23, 0, 0, 0, ny), 4, 0, 0, ny,
+			time.Date(2025, 3, 9, 8, 0, 0, 0, time.UTC)},
+
+		// 2025-11-02 02:00 EDT → 01:00 EST：01:30 出現兩次，取第一次（EDT）
+		{"fall_back_first", time.Date(2025, 11, 2, 0, 0, 0, 0, ny), 1, 30, 0, ny,
+			time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC)},
+		// 第一次 01:30 之後，不會再觸發第二次（EST）的 01:30
+		{"fall_back_no_repeat", time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), 1, 30, 0, ny,
+			time.Date(2025, 11, 3, 6, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextOccurrence(tt.now, tt.h, tt.m, tt.s, tt.loc)
+			if !got.Equal(tt.want) {
+				t.Errorf("NextOccurrence() = %v, want %v", got, tt.want.UTC())
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("NextOccurrence() location = %v, want UTC", got.Location())
+			}
+			if !got.After(tt.now) {
+				t.Errorf("NextOccurrence() = %v, must be after now %v", got, tt.now)
+			}
+		})
+	}
+}
+
+func TestNextWeekdayOccurrence(t *testing.T) {
+	taipei := mustLoad(t, "Asia/Taipei")
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name string
+		now  time.Time
+		wd   time.Weekday
+		loc  *time.Location
+		h, m int
+		want time.Time
+	}{
+		// 2025-01-15 為週三
+		{"next_week_monday", time.Date(2025, 1, 15, 10, 0, 0, 0, taipei), time.Monday, taipei, 9, 0,
+			time.Date(2025, 1, 20, 9, 0, 0, 0, taipei)},
+		{"same_day_later", time.Date(2025, 1, 15, 8, 0, 0, 0, taipei), time.Wednesday, taipei, 9, 0,
+			time.Date(2025, 1, 15, 9, 0, 0, 0, taipei)},
+		{"same_day_exact", time.Date(2025, 1, 15, 9, 0, 0, 0, taipei), time.Wednesday, taipei, 9, 0,
+			time.Date(2025, 1, 22, 9, 0, 0, 0, taipei)},
+		// 2025-03-09 為週日且 02:30 不存在
+		{"weekly_in_gap", time.Date(2025, 3, 5, 12, 0, 0, 0, ny), time.Sunday, ny, 2, 30,
+			time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC)},
+		// 2025-11-02 為週日且 01:30 重複
+		{"weekly_in_overlap", time.Date(2025, 10, 29, 12, 0, 0, 0, ny), time.Sunday, ny, 1, 30,
+			time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextWeekdayOccurrence(tt.now, tt.wd, tt.h, tt.m, 0, tt.loc)
+			if !got.Equal(tt.want) {
+				t.Errorf("NextWeekdayOccurrence() = %v, want %v", got, tt.want.UTC())
+			}
+			if wd := got.In(tt.loc).Weekday(); wd != tt.wd {
+				t.Errorf("NextWeekdayOccurrence() weekday = %v, want %v", wd, tt.wd)
+			}
+		})
+	}
+}
+
+func TestNextOccurrence_NilLocation(t *testing.T) {
+	now := time.Date(2025, 8, 19, 10, 0, 0, 0, time.UTC) // 星期二
+	if got, want := NextOccurrence(now, 2, 30, 0, nil), time.Date(2025, 8, 20, 2, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("NextOccurrence(nil) = %v, want %v", got, want)
+	}
+	if got, want := NextWeekdayOccurrence(now, time.Friday, 9, 0, 0, nil), time.Date(2025, 8, 22, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("NextWeekdayOccurrence(nil) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package timex
+
+import "time"
+
+// RoundTo 將時間四捨五入至指定粒度（剛好一半時進位），以 UTC 作業。
+// 例如 d = 15 分鐘時：10:07:29 → 10:00，10:07:30 → 10:15。
+// d <= 0 時回傳原時間（UTC）。
+func RoundTo(t time.Time, d time.Duration) time.Time {
+	return t.UTC().Round(d)
+}
+
+// CeilTo 將時間無條件進位至指定粒度，以 UTC 作業。
+// 已落在邊界上的時間不會進位到下一個區間（例如 10:00:00 以小時進位仍為 10:00:00）。
+// d <= 0 時回傳原時間（UTC）。
+func CeilTo(t time.Time, d time.Duration) time.Time {
+	if d <= 0 {
+		return t.UTC()
+	}
+	floor := TruncateTo(t, d)
+	if floor.Equal(t) {
+		return floor
+	}
+	return floor.Add(d)
+}
+
+// FloorToLocalDay 將時間向下取至 loc 時區的當地零點（回傳 UTC），等同 StartOfDay。
+// 由於夏令時間使一天不一定是 24 小時，無法以 Truncate(24*time.Hour) 取得。
+func FloorToLocalDay(t time.Time, loc *time.Location) time.Time {
+	return StartOfDay(t, loc)
+}
+
+// FloorToLocalDayIn 同 FloorToLocalDay，但回傳值保留在 loc 時區。
+func FloorToLocalDayIn(t time.Time, loc *time.Location) time.Time {
+	return StartOfDayIn(t, loc)
+}
+
+// CeilToLocalDay 將時間向上取至 loc 時區的下一個當地零點（回傳 UTC），
+// 即 EndOfDay 再加 1ns；已是當地零點的時間維持不變。
+func CeilToLocalDay(t time.Time, loc *time.Location) time.Time {
+	return CeilToLocalDayIn(t, loc).UTC()
+}
+
+// CeilToLocalDayIn 同 CeilToLocalDay，但回傳值保留在 loc 時區。
+func CeilToLocalDayIn(t time.Time, loc *time.Location) time.Time {
+	start := StartOfDayIn(t, loc)
+	if start.Equal(t) {
+		return start
+	}
+	return EndOfDayIn(t, loc).Add(time.Nanosecond)
+}
@@ -0,0 +1,132 @@
+package timex
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestRoundTo(t *testing.T) {
+	base := time.Date(2025, 8, 19, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   time.Time
+		d    time.Duration
+		want time.Time
+	}{
+		{"round_down", base.Add(7*time.Minute + 29*time.Second), 15 * time.Minute, base},
+		{"half_up", base.Add(7*time.Minute + 30*time.Second), 15 * time.Minute, base.Add(15 * time.Minute)},
+		{"round_up", base.Add(53 * time.Minute), 15 * time.Minute, base.Add(time.Hour)},
+		{"boundary", base.Add(15 * time.Minute), 15 * time.Minute, base.Add(15 * time.Minute)},
+		{"zero_duration", base.Add(time.Second), 0, base.Add(time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RoundTo(tt.in, tt.d)
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("RoundTo(%v, %v) = %v, want %v", tt.in, tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCeilTo(t *testing.T) {
+	base := time.Date(2025, 8, 19, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   time.Time
+		d    time.Duration
+		want time.Time
+	}{
+		{"boundary_stays", base, time.Hour, base},
+		{"one_nanosecond_after", base.Add(time.Nanosecond), time.Hour, base.Add(time.Hour)},
+		{"middle", base.Add(30 * time.Minute), time.Hour, base.Add(time.Hour)},
+		{"quarter", base.Add(16 * time.Minute), 15 * time.Minute, base.Add(30 * time.Minute)},
+		{"zero_duration", base.Add(time.Second), 0, base.Add(time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CeilTo(tt.in, tt.d)
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("CeilTo(%v, %v) = %v, want %v", tt.in, tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEndOfDay(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Taipei")
+	in := time.Date(2025, 8, 19, 10, 0, 0, 0, loc)
+
+	// 2025-08-19 23:59:59.999999999+08 = 2025-08-19 15:59:59.999999999 UTC
+	want := time.Date(2025, 8, 19, 15, 59, 59, 999999999, time.UTC)
+	if got := EndOfDay(in, loc); !got.Equal(want) {
+		t.Fatalf("EndOfDay() got %v; want %v", got, want)
+	}
+}
+
+func TestLocalDay_DST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("Europe/London not available: %v", err)
+	}
+
+	// 2025-03-30 為 23 小時的切換日
+	in := time.Date(2025, 3, 30, 12, 0, 0, 0, loc)
+	floor := FloorToLocalDay(in, loc)
+	ceil := CeilToLocalDay(in, loc)
+
+	if want := time.Date(2025, 3, 30, 0, 0, 0, 0, loc); !floor.Equal(want) {
+		t.Errorf("FloorToLocalDay() = %v, want %v", floor, want)
+	}
+	if want := time.Date(2025, 3, 31, 0, 0, 0, 0, loc); !ceil.Equal(want) {
+		t.Errorf("CeilToLocalDay() = %v, want %v", ceil, want)
+	}
+	if got := ceil.Sub(floor); got != 23*time.Hour {
+		t.Errorf("DST day length = %v, want 23h", got)
+	}
+
+	// 已在當地零點不應進位
+	midnight := time.Date(2025, 3, 30, 0, 0, 0, 0, loc)
+	if got := CeilToLocalDay(midnight, loc); !got.Equal(midnight) {
+		t.Errorf("CeilToLocalDay(midnight) = %v, want %v", got, midnight)
+	}
+}
+
+// TestRounding_Properties 以隨機時間驗證 Floor ≤ t ≤ Ceil、冪等性與 Round 誤差範圍。
+func TestRounding_Properties(t *testing.T) {
+	loc := mustLoad(t, "Europe/London")
+	r := rand.New(rand.NewSource(42))
+	durations := []time.Duration{time.Second, time.Minute, 15 * time.Minute, time.Hour}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	for range 1000 {
+		in := time.Unix(start+r.Int63n(10*365*24*3600), r.Int63n(int64(time.Second))).UTC()
+
+		for _, d := range durations {
+			floor, ceil, round := TruncateTo(in, d), CeilTo(in, d), RoundTo(in, d)
+			if floor.After(in) || ceil.Before(in) {
+				t.Fatalf("d=%v: want floor ≤ t ≤ ceil, got %v ≤ %v ≤ %v", d, floor, in, ceil)
+			}
+			if ceil.Sub(floor) > d {
+				t.Fatalf("d=%v: ceil - floor = %v > d", d, ceil.Sub(floor))
+			}
+			if !CeilTo(ceil, d).Equal(ceil) || !RoundTo(round, d).Equal(round) {
+				t.Fatalf("d=%v: CeilTo/RoundTo should be idempotent for %v", d, in)
+			}
+			if round.Before(floor) || round.After(ceil) {
+				t.Fatalf("d=%v: round %v out of [%v, %v]", d, round, floor, ceil)
+			}
+		}
+
+		floorDay, ceilDay := FloorToLocalDay(in, loc), CeilToLocalDay(in, loc)
+		if floorDay.After(in) || ceilDay.Before(in) {
+			t.Fatalf("local day: want floor ≤ t ≤ ceil, got %v ≤ %v ≤ %v", floorDay, in, ceilDay)
+		}
+		if !CeilToLocalDay(ceilDay, loc).Equal(ceilDay) || !FloorToLocalDay(floorDay, loc).Equal(floorDay) {
+			t.Fatalf("local day: Floor/Ceil should be idempotent for %v", in)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package timex
+
+import (
+	"context"
+	"time"
+)
+
+// sleepChunk 為 SleepUntil 單次計時器的最長時間，每段結束後依牆上時間重新計算剩餘時間。
+const sleepChunk = time.Minute
+
+// SleepUntil 等待到 t 為止，期間 ctx 取消時立即回傳 ctx.Err()；到達 t 時回傳 nil，
+// t 已過去時立即回傳 nil（ctx 已取消則仍回傳 ctx.Err()）。
+//
+// 時鐘調整：t 以牆上時間比較（會移除 monotonic 讀值），且計時器最長只設定 sleepChunk（1 分鐘），
+// 每段結束後重新計算剩餘時間。因此 NTP 等校時造成的大幅跳動，最多延遲一個分段就會反映：
+// 時鐘往前跳會提早結束，往後跳會延長等待，而非依啟動時計算的時長睡過頭。
+func SleepUntil(ctx context.Context, t time.Time) error {
+	t = t.Round(0) // 移除 monotonic 讀值，改以牆上時間比較
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		remaining := time.Until(t)
+		if remaining <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(min(remaining, sleepChunk))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// WaitForNextTick 等待 d 的時間，ctx 取消時立即回傳 ctx.Err()。
+// aligned 為 true 時改為等待到下一個 d 的整數倍時刻（以 UTC 牆上時間對齊），
+// 例如 d 為 15 分鐘時等到下一個 :00、:15、:30 或 :45；恰在對齊點上時等待完整的 d。
+// 適用於排程迴圈：
+//
+//	for {
+//	    if err := timex.WaitForNextTick(ctx, time.Minute, true); err != nil {
+//	        return err // 關機
+//	    }
+//	    runJob()
+//	}
+//
+// d ≤ 0 時不等待。時鐘調整的行為同 SleepUntil。
+func WaitForNextTick(ctx context.Context, d time.Duration, aligned bool) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	now := time.Now()
+	if aligned {
+		return SleepUntil(ctx, nextAlignedTick(now, d))
+	}
+	return SleepUntil(ctx, now.Add(d))
+}
+
+// nextAlignedTick 回傳嚴格晚於 now 的下一個 d 整數倍時刻。
+func nextAlignedTick(now time.Time, d time.Duration) time.Time {
+	return now.Truncate(d).Add(d)
+}
@@ -0,0 +1,115 @@
+package timex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSleepUntil(t *testing.T) {
+	t.Run("reaches_target", func(t *testing.T) {
+		target := time.Now().Add(30 * time.Millisecond)
+		if err := SleepUntil(context.Background(), target); err != nil {
+			t.Fatalf("SleepUntil() error = %v", err)
+		}
+		if time.Now().Before(target) {
+			t.Error("SleepUntil() returned before target")
+		}
+	})
+
+	t.Run("past_returns_immediately", func(t *testing.T) {
+		start := time.Now()
+		if err := SleepUntil(context.Background(), start.Add(-time.Hour)); err != nil {
+			t.Fatalf("SleepUntil() error = %v", err)
+		}
+		if time.Since(start) > 50*time.Millisecond {
+			t.Error("SleepUntil() with past time should return immediately")
+		}
+	})
+
+	t.Run("cancelled_early", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+
+		start := time.Now()
+		err := SleepUntil(ctx, time.Now().Add(time.Hour))
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("SleepUntil() error = %v, want context.Canceled", err)
+		}
+		if time.Since(start) > time.Second {
+			t.Error("SleepUntil() should return promptly after cancel")
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if err := SleepUntil(ctx, time.Now().Add(time.Hour)); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("SleepUntil() error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	t.Run("already_cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := SleepUntil(ctx, time.Now().Add(-time.Hour)); !errors.Is(err, context.Canceled) {
+			t.Fatalf("SleepUntil() error = %v, want context.Canceled", err)
+		}
+	})
+}
+
+func TestWaitForNextTick(t *testing.T) {
+	t.Run("not_aligned", func(t *testing.T) {
+		start := time.Now()
+		if err := WaitForNextTick(context.Background(), 30*time.Millisecond, false); err != nil {
+			t.Fatalf("WaitForNextTick() error = %v", err)
+		}
+		if time.Since(start) < 30*time.Millisecond {
+			t.Error("WaitForNextTick() returned too early")
+		}
+	})
+
+	t.Run("aligned", func(t *testing.T) {
+		d := 50 * time.Millisecond
+		if err := WaitForNextTick(context.Background(), d, true); err != nil {
+			t.Fatalf("WaitForNextTick() error = %v", err)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		if err := WaitForNextTick(ctx, time.Hour, true); !errors.Is(err, context.Canceled) {
+			t.Fatalf("WaitForNextTick() error = %v, want context.Canceled", err)
+		}
+	})
+
+	t.Run("non_positive", func(t *testing.T) {
+		if err := WaitForNextTick(context.Background(), 0, true); err != nil {
+			t.Fatalf("WaitForNextTick(0) error = %v", err)
+		}
+	})
+}
+
+func TestNextAlignedTick(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+		d    time.Duration
+		want time.Time
+	}{
+		{"quarter", time.Date(2025, 1, 1, 10, 7, 30, 0, time.UTC), 15 * time.Minute, time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC)},
+		{"on_boundary", time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC), 15 * time.Minute, time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)},
+		{"hour_rollover", time.Date(2025, 1, 1, 10, 59, 59, 0, time.UTC), time.Minute, time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{"day_rollover", time.Date(2025, 1, 1, 23, 50, 0, 0, time.UTC), time.Hour, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextAlignedTick(tt.now, tt.d); !got.Equal(tt.want) {
+				t.Errorf("nextAlignedTick() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package timex
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Lap 為 Stopwatch 記錄的具名檢查點。
+type Lap struct {
+	Name  string        // 檢查點名稱
+	At    time.Duration // 距離碼表啟動的累計時間
+	Split time.Duration // 距離上一個檢查點（或啟動）的時間
+}
+
+// Stopwatch 量測經過時間並記錄具名檢查點（lap）。
+// 使用 monotonic clock 計時，不受系統時間調整影響；可併發呼叫 Lap。
+type Stopwatch struct {
+	start time.Time
+
+	mu   sync.Mutex
+	laps []Lap
+}
+
+// NewStopwatch 建立並立即啟動碼表。
+func NewStopwatch() *Stopwatch {
+	return &Stopwatch{start: time.Now()}
+}
+
+// Elapsed 回傳碼表啟動至今的經過時間；nil Stopwatch 回傳 0。
+func (s *Stopwatch) Elapsed() time.Duration {
+	if s == nil {
+		return 0
+	}
+	return time.Since(s.start)
+}
+
+// ElapsedMillis 回傳碼表啟動至今的經過毫秒數。
+func (s *Stopwatch) ElapsedMillis() int64 {
+	return s.Elapsed().Milliseconds()
+}
+
+// Lap 記錄具名檢查點，並回傳距離上一個檢查點的時間。
+// 對 nil Stopwatch 呼叫為 no-op（方便搭配 StopwatchFromContext 使用）。
+func (s *Stopwatch) Lap(name string) time.Duration {
+	if s == nil {
+		return 0
+	}
+
+	// 持有鎖時才讀取時間，確保併發呼叫時 laps 依時間排序且 Split 不為負
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	at := time.Since(s.start)
+
+	var prev time.Duration
+	if n := len(s.laps); n > 0 {
+		prev = s.laps[n-1].At
+	}
+	split := at - prev
+	s.laps = append(s.laps, Lap{Name: name, At: at, Split: split})
+	return split
+}
+
+// Laps 回傳目前已記錄的檢查點（複本）；nil Stopwatch 回傳 nil。
+func (s *Stopwatch) Laps() []Lap {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]Lap, len(s.laps))
+	copy(out, s.laps)
+	return out
+}
+
+// String 回傳 "total=1.23s step1=0.4s step2=0.83s" 格式的摘要，各檢查點顯示其 Split；
+// nil Stopwatch 回傳 "total=0s"。
+func (s *Stopwatch) String() string {
+	total := s.Elapsed()
+	laps := s.Laps()
+
+	var b strings.Builder
+	b.WriteString("total=")
+	b.WriteString(formatSeconds(total))
+	for _, l := range laps {
+		b.WriteByte(' ')
+		b.WriteString(l.Name)
+		b.WriteByte('=')
+		b.WriteString(formatSeconds(l.Split))
+	}
+	return b.String()
+}
+
+// LogValue 實作 slog.LogValuer，輸出 total 與各檢查點 Split 的結構化欄位：
+//
+//	logger.Info("done", "timing", sw)
+//	// timing.total=1.23s timing.step1=400ms ...
+//
+// nil Stopwatch 回傳空的 group（slog 會省略該欄位），可直接記錄 StopwatchFromContext 的結果。
+func (s *Stopwatch) LogValue() slog.Value {
+	if s == nil {
+		return slog.GroupValue()
+	}
+	total := s.Elapsed()
+	laps := s.Laps()
+
+	attrs := make([]slog.Attr, 0, len(laps)+1)
+	attrs = append(attrs, slog.Duration("total", total))
+	for _, l := range laps {
+		attrs = append(attrs, slog.Duration(l.Name, l.Split))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// formatSeconds 以秒為單位格式化時間（最多三位小數，去除尾端 0），例如 1.23s、0.4s。
+func formatSeconds(d time.Duration) string {
+	s := strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	return s + "s"
+}
+
+// stopwatchKey 為 context 中存放 Stopwatch 的 key。
+type stopwatchKey struct{}
+
+// WithStopwatch 回傳攜帶 sw 的 context，供 middleware 啟動碼表、handler 記錄檢查點。
+func WithStopwatch(ctx context.Context, sw *Stopwatch) context.Context {
+	return context.WithValue(ctx, stopwatchKey{}, sw)
+}
+
+// StopwatchFromContext 取出 context 中的 Stopwatch，若不存在回傳 nil。
+// 由於所有方法對 nil 皆安全（Lap 為 no-op、其餘回傳零值），handler 可直接呼叫 StopwatchFromContext(ctx).Lap("db")。
+func StopwatchFromContext(ctx context.Context) *Stopwatch {
+	sw, _ := ctx.Value(stopwatchKey{}).(*Stopwatch)
+	return sw
+}
@@ -0,0 +1,163 @@
+package timex
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStopwatch_Elapsed(t *testing.T) {
+	sw := NewStopwatch()
+	time.Sleep(5 * time.Millisecond)
+
+	if sw.Elapsed() < 5*time.Millisecond {
+		t.Errorf("Elapsed() = %v, want >= 5ms", sw.Elapsed())
+	}
+	if sw.ElapsedMillis() < 5 {
+		t.Errorf("ElapsedMillis() = %d, want >= 5", sw.ElapsedMillis())
+	}
+}
+
+func TestStopwatch_Laps(t *testing.T) {
+	sw := NewStopwatch()
+	time.Sleep(2 * time.Millisecond)
+	sw.Lap("step1")
+	time.Sleep(2 * time.Millisecond)
+	sw.Lap("step2")
+
+	laps := sw.Laps()
+	if len(laps) != 2 || laps[0].Name != "step1" || laps[1].Name != "step2" {
+		t.Fatalf("Laps() = %+v", laps)
+	}
+	if laps[1].At != laps[0].At+laps[1].Split {
+		t.Errorf("lap At/Split mismatch: %+v", laps)
+	}
+	if laps[0].Split < 2*time.Millisecond || laps[1].Split < 2*time.Millisecond {
+		t.Errorf("lap split too small: %+v", laps)
+	}
+
+	// Laps 回傳複本，修改不影響內部狀態
+	laps[0].Name = "changed"
+	if sw.Laps()[0].Name != "step1" {
+		t.Error("Laps() should return a copy")
+	}
+}
+
+func TestStopwatch_String(t *testing.T) {
+	sw := NewStopwatch()
+	sw.Lap("step1")
+	sw.Lap("step2")
+
+	assertMatch(t, sw.String(), `^total=\d+(\.\d+)?s step1=\d+(\.\d+)?s step2=\d+(\.\d+)?s$`)
+}
+
+func TestFormatSeconds(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{1230 * time.Millisecond, "1.23s"},
+		{400 * time.Millisecond, "0.4s"},
+		{3 * time.Millisecond, "0.003s"},
+		{2 * time.Second, "2s"},
+		{0, "0s"},
+	}
+
+	for _, tt := range tests {
+		if got := formatSeconds(tt.in); got != tt.want {
+			t.Errorf("formatSeconds(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestStopwatch_ConcurrentLap(t *testing.T) {
+	sw := NewStopwatch()
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sw.Lap("worker")
+		}()
+	}
+	wg.Wait()
+
+	laps := sw.Laps()
+	if n := len(laps); n != 50 {
+		t.Errorf("len(Laps()) = %d, want 50", n)
+	}
+	var prev time.Duration
+	for i, l := range laps {
+		if l.At < prev {
+			t.Errorf("laps[%d].At = %v, before previous %v", i, l.At, prev)
+		}
+		if l.Split < 0 {
+			t.Errorf("laps[%d].Split = %v, want >= 0", i, l.Split)
+		}
+		prev = l.At
+	}
+}
+
+func TestStopwatch_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	sw := NewStopwatch()
+	sw.Lap("db")
+	logger.Info("done", "timing", sw)
+
+	out := buf.String()
+	if !strings.Contains(out, "timing.total=") || !strings.Contains(out, "timing.db=") {
+		t.Errorf("log output missing structured fields: %s", out)
+	}
+	if regexp.MustCompile(`timing=`).MatchString(out) {
+		t.Errorf("timing should be a group, got: %s", out)
+	}
+}
+
+func TestStopwatch_Context(t *testing.T) {
+	sw := NewStopwatch()
+	ctx := WithStopwatch(context.Background(), sw)
+
+	StopwatchFromContext(ctx).Lap("handler")
+	if len(sw.Laps()) != 1 {
+		t.Error("lap should be recorded on the stopwatch in context")
+	}
+
+	// 沒有 Stopwatch 的 context 回傳 nil，且 Lap 不 panic
+	missing := StopwatchFromContext(context.Background())
+	if missing != nil {
+		t.Fatal("StopwatchFromContext should return nil when absent")
+	}
+	if d := missing.Lap("noop"); d != 0 {
+		t.Errorf("nil Lap() = %v, want 0", d)
+	}
+}
+
+func TestStopwatch_NilSafe(t *testing.T) {
+	var sw *Stopwatch
+	if d := sw.Elapsed(); d != 0 {
+		t.Errorf("nil Elapsed() = %v, want 0", d)
+	}
+	if ms := sw.ElapsedMillis(); ms != 0 {
+		t.Errorf("nil ElapsedMillis() = %v, want 0", ms)
+	}
+	if laps := sw.Laps(); laps != nil {
+		t.Errorf("nil Laps() = %v, want nil", laps)
+	}
+	if s := sw.String(); s != "total=0s" {
+		t.Errorf("nil String() = %q, want %q", s, "total=0s")
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	logger.Info("done", "timing", sw)
+	if strings.Contains(buf.String(), "timing") {
+		t.Errorf("nil stopwatch should be omitted from log, got %q", buf.String())
+	}
+}
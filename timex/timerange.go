@@ -0,0 +1,20 @@
+package timex
+
+import "time"
+
+// TimeRange 表示半開區間 [Start, End)，包含 Start、不包含 End，
+// 相鄰的區間因此不會重疊（例如連續的計費週期）。
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains 判斷 t 是否位於 [Start, End) 內。
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// Duration 回傳區間長度（End - Start）。
+func (r TimeRange) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
@@ -1,25 +1,83 @@
 package timex
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
 
+// 本套件時間戳函式使用的格式常數，可用於 time.Parse 還原。
+const (
+	// LayoutISO8601Milli 帶毫秒與冒號時區的 ISO 8601 格式（TimeStamp、WithZoneTimeStamp）。
+	// 例如：2025-12-19T18:30:00.000+08:00；UTC 時輸出 Z。
+	LayoutISO8601Milli = "2006-01-02T15:04:05.000Z07:00"
+	// LayoutISO8601CompactTZ 帶毫秒與無冒號時區的 ISO 8601 格式（FormatISO8601）。
+	// 例如：2025-12-19T18:30:00.000+0800
+	LayoutISO8601CompactTZ = "2006-01-02T15:04:05.000-0700"
+	// LayoutUTCMilli 固定以 Z 結尾的 UTC 毫秒格式（TimeStampUTC）。
+	// 例如：2025-12-19T10:30:00.000Z
+	LayoutUTCMilli = "2006-01-02T15:04:05.000Z"
+	// LayoutDate 日期格式（DateStamp）。例如：2025-12-19
+	LayoutDate = "2006-01-02"
+	// LayoutTimeOnly 時間格式（TimeOnlyStamp）。例如：10:30:00
+	LayoutTimeOnly = "15:04:05"
+)
+
+// stampLayouts 為 ParseStamp 嘗試的格式（依序）。
+// LayoutISO8601Milli 的 Z07:00 也可解析 LayoutUTCMilli 產生的 Z 結尾字串。
+var stampLayouts = []string{
+	LayoutISO8601Milli,
+	LayoutISO8601CompactTZ,
+	LayoutDate,
+	LayoutTimeOnly,
+}
+
+// ErrUnknownStampFormat 表示字串不符合本套件任何時間戳格式。
+var ErrUnknownStampFormat = errors.New("timex: unknown stamp format")
+
 // NowUTC 取得目前 UTC 時間。
 // 使用 time.Now().UTC()，避免隱含時區引發序列化/跨服務差異。
 func NowUTC() time.Time {
 	return time.Now().UTC()
 }
 
-// StartOfDay 回傳指定時區下某時刻的「零點」時間（當地日界）。
-// 1) 先將 t 轉到指定時區 loc
-// 2) 取當地年月日
-// 3) 建立當地零點，再轉回 UTC（便於儲存/比較）
+// StartOfDay 回傳指定時區下某時刻的「零點」時間（當地日界），並轉回 UTC（便於儲存/比較）。
+// 需要直接顯示當地時間時請使用 StartOfDayIn。loc 為 nil 時視為 UTC。
 func StartOfDay(t time.Time, loc *time.Location) time.Time {
-	local := t.In(loc)                               // 轉到目標時區
-	y, m, d := local.Date()                          // 取當地年月日
-	zeroLocal := time.Date(y, m, d, 0, 0, 0, 0, loc) // 當地零點
-	return zeroLocal.UTC()                           // 標準化成 UTC
+	return StartOfDayIn(t, loc).UTC()
+}
+
+// StartOfDayIn 同 StartOfDay，但回傳值保留在 loc 時區（適用於格式化顯示）。
+// 1) 先將 t 轉到指定時區 loc（nil 時視為 UTC，而非在 time.Date 中 panic）
+// 2) 取當地年月日
+// 3) 建立當地零點
+func StartOfDayIn(t time.Time, loc *time.Location) time.Time {
+	loc = locationOrUTC(loc)
+	y, m, d := t.In(loc).Date()                // 取當地年月日
+	return time.Date(y, m, d, 0, 0, 0, 0, loc) // 當地零點
+}
+
+// EndOfDay 回傳指定時區下某時刻當天的最後一個時間點（23:59:59.999999999，當地日界），並轉回 UTC。
+// 以「隔日零點減 1ns」計算，因此夏令時間切換日（23 或 25 小時）也正確。loc 為 nil 時視為 UTC。
+func EndOfDay(t time.Time, loc *time.Location) time.Time {
+	return EndOfDayIn(t, loc).UTC()
+}
+
+// EndOfDayIn 同 EndOfDay，但回傳值保留在 loc 時區（適用於格式化顯示）。
+func EndOfDayIn(t time.Time, loc *time.Location) time.Time {
+	loc = locationOrUTC(loc)
+	y, m, d := t.In(loc).Date()                        // 取當地年月日
+	nextLocal := time.Date(y, m, d+1, 0, 0, 0, 0, loc) // 當地隔日零點
+	return nextLocal.Add(-time.Nanosecond)             // 減 1ns
+}
+
+// locationOrUTC 在 loc 為 nil 時回傳 time.UTC。
+// time.Time.In 與 time.Date 遇到 nil 會 panic，日界計算一律以 UTC 作為預設時區。
+func locationOrUTC(loc *time.Location) *time.Location {
+	if loc == nil {
+		return time.UTC
+	}
+	return loc
 }
 
 // TruncateTo 將時間截斷至指定粒度（如分鐘/小時），以 UTC 作業避免跨時區差異。
@@ -39,17 +97,17 @@ func ParseTime(str, layout string) (time.Time, error) {
 
 // TimeStamp 取得目前時間的時間戳。
 func TimeStamp() string {
-	return time.Now().Format("2006-01-02T15:04:05.000Z07:00")
+	return time.Now().Format(LayoutISO8601Milli)
 }
 
 // TimeStampUTC 取得目前時間的 UTC 時間戳。
 func TimeStampUTC() string {
-	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+	return time.Now().UTC().Format(LayoutUTCMilli)
 }
 
 // DateStamp 取得目前日期（YYYY-MM-DD）。
 func DateStamp() string {
-	return time.Now().Format("2006-01-02")
+	return time.Now().Format(LayoutDate)
 }
 
 // UnixTimeStamp 取得目前時間的 Unix 秒數字串。
@@ -64,16 +122,38 @@ func UnixMilliStamp() string {
 
 // TimeOnlyStamp 取得目前時間（HH:MM:SS）。
 func TimeOnlyStamp() string {
-	return time.Now().Format("15:04:05")
+	return time.Now().Format(LayoutTimeOnly)
 }
 
 // WithZoneTimeStamp 回傳指定時區的當下時間戳（ISO 8601 格式）。
 func WithZoneTimeStamp(loc *time.Location) string {
-	return time.Now().In(loc).Format("2006-01-02T15:04:05.000Z07:00")
+	return time.Now().In(loc).Format(LayoutISO8601Milli)
 }
 
 // FormatISO8601 將時間格式化為 ISO 8601 格式（帶毫秒與無冒號時區）。
 // 例如：2006-01-02T15:04:05.000+0800
 func FormatISO8601(t time.Time) string {
-	return t.Format("2006-01-02T15:04:05.000-0700")
+	return t.Format(LayoutISO8601CompactTZ)
+}
+
+// Stamp 以指定格式回傳目前本地時間的時間戳。
+func Stamp(layout string) string {
+	return time.Now().Format(layout)
+}
+
+// StampIn 以指定格式回傳目前 loc 時區的時間戳。
+func StampIn(loc *time.Location, layout string) string {
+	return time.Now().In(loc).Format(layout)
+}
+
+// ParseStamp 解析本套件時間戳函式產生的字串，依序嘗試
+// LayoutISO8601Milli（含 LayoutUTCMilli）、LayoutISO8601CompactTZ、LayoutDate、LayoutTimeOnly。
+// 不含時區的格式（日期、時間）解析為 UTC；皆不符合時回傳 ErrUnknownStampFormat。
+func ParseStamp(s string) (time.Time, error) {
+	for _, layout := range stampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q", ErrUnknownStampFormat, s)
 }
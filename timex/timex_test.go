@@ -1,6 +1,7 @@
 package timex
 
 import (
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -27,6 +28,61 @@ func TestStartOfDay(t *testing.T) {
 	}
 }
 
+func TestDayBoundaryIn_KeepsLocation(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Taipei")
+	in := time.Date(2025, 8, 19, 10, 0, 0, 0, time.UTC) // 18:00+08
+
+	tests := []struct {
+		name  string
+		in    func(time.Time, *time.Location) time.Time
+		utc   func(time.Time, *time.Location) time.Time
+		local string
+	}{
+		{"StartOfDayIn", StartOfDayIn, StartOfDay, "2025-08-19T00:00:00.000+08:00"},
+		{"EndOfDayIn", EndOfDayIn, EndOfDay, "2025-08-19T23:59:59.999+08:00"},
+		{"FloorToLocalDayIn", FloorToLocalDayIn, FloorToLocalDay, "2025-08-19T00:00:00.000+08:00"},
+		{"CeilToLocalDayIn", CeilToLocalDayIn, CeilToLocalDay, "2025-08-20T00:00:00.000+08:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.in(in, loc)
+			if got.Location() != loc {
+				t.Errorf("location = %v, want %v", got.Location(), loc)
+			}
+			if s := got.Format(LayoutISO8601Milli); s != tt.local {
+				t.Errorf("got %s, want %s", s, tt.local)
+			}
+			// UTC 版本為同一時刻
+			utc := tt.utc(in, loc)
+			if !utc.Equal(got) || utc.Location() != time.UTC {
+				t.Errorf("UTC variant = %v, want %v in UTC", utc, got)
+			}
+		})
+	}
+}
+
+func TestDayBoundary_NilLocation(t *testing.T) {
+	in := time.Date(2025, 8, 19, 10, 30, 0, 0, time.FixedZone("CST", 8*3600)) // 02:30 UTC
+
+	// nil 時區視為 UTC，而非 panic
+	if got, want := StartOfDay(in, nil), time.Date(2025, 8, 19, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfDay(nil) = %v, want %v", got, want)
+	}
+	if got := StartOfDayIn(in, nil); got.Location() != time.UTC {
+		t.Errorf("StartOfDayIn(nil) location = %v, want UTC", got.Location())
+	}
+	if got, want := EndOfDay(in, nil), time.Date(2025, 8, 19, 23, 59, 59, 999999999, time.UTC); !got.Equal(want) {
+		t.Errorf("EndOfDay(nil) = %v, want %v", got, want)
+	}
+	if got, want := CeilToLocalDay(in, nil), time.Date(2025, 8, 20, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("CeilToLocalDay(nil) = %v, want %v", got, want)
+	}
+	if got, want := FloorToLocalDayIn(in, nil), time.Date(2025, 8, 19, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("FloorToLocalDayIn(nil) = %v, want %v", got, want)
+	}
+}
+
 func TestTruncateTo(t *testing.T) {
 	// 2025-08-19 10:30:45+08
 	in := time.Date(2025, 8, 19, 10, 30, 45, 0, time.UTC)
@@ -137,3 +193,53 @@ func TestFormatISO8601(t *testing.T) {
 	// Regex check for general validity
 	assertMatch(t, got, `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}[+-]\d{4}$`)
 }
+
+func TestParseStamp_RoundTrip(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Taipei")
+	in := time.Date(2026, 1, 12, 18, 9, 11, 123_000_000, loc)
+
+	tests := []struct {
+		name string
+		in   string
+		want time.Time
+	}{
+		{"iso8601_milli", in.Format(LayoutISO8601Milli), in},
+		{"utc_milli", in.UTC().Format(LayoutUTCMilli), in},
+		{"compact_tz", FormatISO8601(in), in},
+		{"date", in.Format(LayoutDate), time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)},
+		{"time_only", in.Format(LayoutTimeOnly), time.Date(0, 1, 1, 18, 9, 11, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStamp(tt.in)
+			if err != nil {
+				t.Fatalf("ParseStamp(%q) error: %v", tt.in, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseStamp(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStamp_Stamps(t *testing.T) {
+	for _, s := range []string{TimeStamp(), TimeStampUTC(), DateStamp(), TimeOnlyStamp(), FormatISO8601(time.Now())} {
+		if _, err := ParseStamp(s); err != nil {
+			t.Errorf("ParseStamp(%q) error: %v", s, err)
+		}
+	}
+}
+
+func TestParseStamp_Invalid(t *testing.T) {
+	_, err := ParseStamp("2025/12/19")
+	if !errors.Is(err, ErrUnknownStampFormat) {
+		t.Errorf("ParseStamp error = %v, want ErrUnknownStampFormat", err)
+	}
+}
+
+func TestStampIn(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Taipei")
+	assertMatch(t, StampIn(loc, LayoutISO8601Milli), `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}\+08:00$`)
+	assertMatch(t, Stamp(LayoutDate), `^\d{4}-\d{2}-\d{2}$`)
+}
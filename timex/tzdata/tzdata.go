@@ -0,0 +1,12 @@
+// Package tzdata 內嵌 IANA 時區資料庫，讓 timex 在未安裝 tzdata 的環境中仍可載入時區。
+//
+// 精簡容器（如 scratch、distroless static）通常沒有 /usr/share/zoneinfo，
+// 此時 time.LoadLocation 會回傳錯誤。於 main 套件以空白匯入即可啟用：
+//
+//	import _ "github.com/vincent119/commons/timex/tzdata"
+//
+// 注意：內嵌資料約增加 450KB 執行檔大小，因此採選擇性匯入；
+// 亦可改用 go build -tags timetzdata 達到相同效果。
+package tzdata
+
+import _ "time/tzdata" // 內嵌時區資料
@@ -0,0 +1,67 @@
+package timex
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// locationCache 快取已載入的時區，避免每次呼叫 time.LoadLocation 都讀取 zoneinfo。
+var locationCache sync.Map // map[string]*time.Location
+
+// LoadLocationCached 載入指定名稱的時區，並將結果快取於記憶體中（可併發呼叫）。
+// 載入失敗的結果不會被快取，以便日後補上 tzdata 後可重新載入。
+//
+// 注意：在未安裝 tzdata 的精簡容器（如 scratch）中，請匯入
+// github.com/vincent119/commons/timex/tzdata 以內嵌時區資料。
+func LoadLocationCached(name string) (*time.Location, error) {
+	if v, ok := locationCache.Load(name); ok {
+		return v.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := locationCache.LoadOrStore(name, loc)
+	return actual.(*time.Location), nil
+}
+
+// MustLoadLocation 同 LoadLocationCached，但載入失敗時 panic。
+// 適用於程式初始化階段載入固定設定的時區。
+func MustLoadLocation(name string) *time.Location {
+	loc, err := LoadLocationCached(name)
+	if err != nil {
+		panic(fmt.Sprintf("timex: load location %q: %v", name, err))
+	}
+	return loc
+}
+
+// ConvertZone 將 t 的牆上時間（wall clock）視為 fromName 時區的時間，並轉換為 toName 時區。
+// 例如 10:00（Asia/Taipei）轉為 UTC 會得到 02:00。
+func ConvertZone(t time.Time, fromName, toName string) (time.Time, error) {
+	from, err := LoadLocationCached(fromName)
+	if err != nil {
+		return time.Time{}, err
+	}
+	to, err := LoadLocationCached(toName)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	y, m, d := t.Date()
+	src := time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), from)
+	return src.In(to), nil
+}
+
+// IsValidTimezone 判斷時區名稱是否有效（適用於驗證使用者輸入的時區設定）。
+// 空字串在 time.LoadLocation 中代表 UTC，但此處視為無效輸入；
+// "Local" 依賴主機設定，亦視為無效。
+func IsValidTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := LoadLocationCached(name)
+	return err == nil
+}
@@ -0,0 +1,83 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadLocationCached(t *testing.T) {
+	loc1, err := LoadLocationCached("Asia/Taipei")
+	if err != nil {
+		t.Fatalf("LoadLocationCached error: %v", err)
+	}
+	loc2, err := LoadLocationCached("Asia/Taipei")
+	if err != nil {
+		t.Fatalf("LoadLocationCached error: %v", err)
+	}
+	if loc1 != loc2 {
+		t.Error("LoadLocationCached should return the cached *time.Location")
+	}
+
+	if _, err := LoadLocationCached("Invalid/Zone"); err == nil {
+		t.Error("LoadLocationCached should fail for invalid zone")
+	}
+}
+
+func TestMustLoadLocation(t *testing.T) {
+	if loc := MustLoadLocation("UTC"); loc.String() != "UTC" {
+		t.Errorf("MustLoadLocation(UTC) = %v", loc)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustLoadLocation should panic for invalid zone")
+		}
+	}()
+	MustLoadLocation("Invalid/Zone")
+}
+
+func TestConvertZone(t *testing.T) {
+	// 2025-08-19 10:00:00 視為台北時間 -> UTC 02:00
+	in := time.Date(2025, 8, 19, 10, 0, 0, 0, time.UTC)
+	got, err := ConvertZone(in, "Asia/Taipei", "UTC")
+	if err != nil {
+		t.Fatalf("ConvertZone error: %v", err)
+	}
+	want := time.Date(2025, 8, 19, 2, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ConvertZone() got %v; want %v", got, want)
+	}
+	if got.Location().String() != "UTC" {
+		t.Errorf("ConvertZone() location = %v; want UTC", got.Location())
+	}
+
+	if _, err := ConvertZone(in, "Invalid/Zone", "UTC"); err == nil {
+		t.Error("ConvertZone should fail for invalid from zone")
+	}
+	if _, err := ConvertZone(in, "UTC", "Invalid/Zone"); err == nil {
+		t.Error("ConvertZone should fail for invalid to zone")
+	}
+}
+
+func TestIsValidTimezone(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want bool
+	}{
+		{"taipei", "Asia/Taipei", true},
+		{"utc", "UTC", true},
+		{"london", "Europe/London", true},
+		{"empty", "", false},
+		{"local", "Local", false},
+		{"invalid", "Mars/Olympus", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidTimezone(tt.in); got != tt.want {
+				t.Errorf("IsValidTimezone(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}